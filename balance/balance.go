@@ -0,0 +1,48 @@
+package balance
+
+import (
+	"context"
+	"github.com/hub1989/paystack-api-wrapper/client"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type Service interface {
+	Get(ctx context.Context) (*List, error)
+	Ledger(ctx context.Context, opts *LedgerOptions) (*LedgerList, error)
+}
+
+// DefaultBalanceService handles operations related to the integration's balance
+// For more details see https://paystack.com/docs/api/transfer-control/
+type DefaultBalanceService struct {
+	*client.Client
+}
+
+// Get returns the balance of every currency of the integration
+// For more details see https://paystack.com/docs/api/transfer-control/#balance
+func (s *DefaultBalanceService) Get(ctx context.Context) (*List, error) {
+	balances := &List{}
+	err := s.Client.Call(ctx, http.MethodGet, "/balance", nil, balances)
+	return balances, err
+}
+
+// Ledger returns the debits and credits on the integration's balance
+// For more details see https://paystack.com/docs/api/transfer-control/#balance-ledger
+func (s *DefaultBalanceService) Ledger(ctx context.Context, opts *LedgerOptions) (*LedgerList, error) {
+	params := url.Values{}
+	if opts != nil {
+		params.Set("from", opts.From)
+		params.Set("to", opts.To)
+		if opts.PerPage > 0 {
+			params.Set("perPage", strconv.Itoa(opts.PerPage))
+		}
+		if opts.Page > 0 {
+			params.Set("page", strconv.Itoa(opts.Page))
+		}
+	}
+
+	entries := &LedgerList{}
+	err := s.Client.Call(ctx, http.MethodGet, client.AddQuery("/balance/ledger", params), nil, entries)
+	return entries, err
+}
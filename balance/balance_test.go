@@ -0,0 +1,68 @@
+package balance
+
+import (
+	"context"
+	"github.com/hub1989/paystack-api-wrapper/client"
+	"github.com/hub1989/paystack-api-wrapper/configuration"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+var c *client.Client
+var service *DefaultBalanceService
+
+func init() {
+	apiKey := client.MustGetTestKey()
+	c = configuration.NewClient(apiKey, nil, true)
+	service = &DefaultBalanceService{Client: c}
+}
+
+func TestGetBalance(t *testing.T) {
+	balances, err := service.Get(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(balances.Values) == 0 {
+		t.Fatalf("Expected at least one balance")
+	}
+
+	b := balances.Values[0]
+	if found, ok := balances.Currency(b.Currency); !ok || found != b {
+		t.Errorf("Expected to find the %s balance, got %+v", b.Currency, found)
+	}
+}
+
+func TestBalanceLedger(t *testing.T) {
+	entries, err := service.Ledger(context.TODO(), &LedgerOptions{PerPage: 5, Page: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries.Values) > 5 {
+		t.Errorf("Expected at most 5 ledger entries, got %d", len(entries.Values))
+	}
+}
+
+func TestBalanceLedgerLargeAmounts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status": true, "data": [{"id": 1, "currency": "NGN", "balance": 1234567891, "difference": -16777217}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c := configuration.NewClient("sk_test", server.Client(), false)
+	c.BaseURL, _ = url.Parse(server.URL)
+	service := &DefaultBalanceService{Client: c}
+
+	entries, err := service.Ledger(context.TODO(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// beyond the range float32 holds exactly
+	if len(entries.Values) != 1 || entries.Values[0].Balance != 1234567891 || entries.Values[0].Difference != -16777217 {
+		t.Errorf("Expected exact kobo amounts, got %+v", entries.Values)
+	}
+}
@@ -0,0 +1,57 @@
+package balance
+
+import "github.com/hub1989/paystack-api-wrapper/response"
+
+// Balance is the amount available in a currency, in the currency's subunit
+// For more details see https://paystack.com/docs/api/transfer-control/#balance
+type Balance struct {
+	Currency string `json:"currency,omitempty"`
+	Balance  int64  `json:"balance"`
+}
+
+// List is a list object for balances, one per currency.
+type List struct {
+	Values []Balance `json:"data"`
+}
+
+// Currency returns the balance held in currency
+func (l *List) Currency(currency string) (Balance, bool) {
+	for _, b := range l.Values {
+		if b.Currency == currency {
+			return b, true
+		}
+	}
+	return Balance{}, false
+}
+
+// LedgerEntry is a debit or credit on the integration's balance. Amounts are in the currency's subunit.
+type LedgerEntry struct {
+	ID          int    `json:"id,omitempty"`
+	Integration int    `json:"integration,omitempty"`
+	Domain      string `json:"domain,omitempty"`
+	Balance     int64  `json:"balance"`
+	Currency    string `json:"currency,omitempty"`
+	// Difference is the amount credited, or debited when negative
+	Difference int64  `json:"difference"`
+	Reason     string `json:"reason,omitempty"`
+	// ModelResponsible is the kind of resource that caused the entry, e.g. Transfer or Settlement
+	ModelResponsible string `json:"model_responsible,omitempty"`
+	ModelRow         int    `json:"model_row,omitempty"`
+	CreatedAt        string `json:"createdAt,omitempty"`
+	UpdatedAt        string `json:"updatedAt,omitempty"`
+}
+
+// LedgerOptions filters the entries returned by Ledger
+type LedgerOptions struct {
+	// From and To limit the creation date range, e.g. 2016-09-21T00:00:00.000Z
+	From    string
+	To      string
+	PerPage int
+	Page    int
+}
+
+// LedgerList is a list object for balance ledger entries.
+type LedgerList struct {
+	Meta   response.ListMeta
+	Values []LedgerEntry `json:"data"`
+}
@@ -6,10 +6,14 @@ import (
 	"github.com/hub1989/paystack-api-wrapper/client"
 	"github.com/hub1989/paystack-api-wrapper/response"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
 type Service interface {
 	List(ctx context.Context) (*List, error)
+	ListN(ctx context.Context, count, offset int) (*List, error)
+	ListWithOptions(ctx context.Context, opts *ListOptions) (*List, error)
 	ResolveBVN(ctx context.Context, bvn int) (*BVNResponse, error)
 	ResolveAccountNumber(ctx context.Context, accountNumber, bankCode string) (response.Response, error)
 }
@@ -28,6 +32,44 @@ func (s *DefaultBankService) List(ctx context.Context) (*List, error) {
 	return banks, err
 }
 
+// ListN returns a page of banks
+// For more details see https://developers.paystack.co/v1.0/reference#list-banks
+func (s *DefaultBankService) ListN(ctx context.Context, count, offset int) (*List, error) {
+	u := client.PaginateURL("/bank", count, offset)
+	banks := &List{}
+	err := s.Client.Call(ctx, http.MethodGet, u, nil, banks)
+	return banks, err
+}
+
+// ListWithOptions returns the banks matching opts
+// For more details see https://paystack.com/docs/api/miscellaneous/#bank
+func (s *DefaultBankService) ListWithOptions(ctx context.Context, opts *ListOptions) (*List, error) {
+	params := url.Values{}
+	if opts != nil {
+		params.Set("country", opts.Country)
+		params.Set("currency", opts.Currency)
+		params.Set("type", string(opts.Type))
+		if opts.PayWithBank {
+			params.Set("pay_with_bank", "true")
+		}
+		if opts.PayWithBankTransfer {
+			params.Set("pay_with_bank_transfer", "true")
+		}
+		if opts.UseCursor {
+			params.Set("use_cursor", "true")
+			params.Set("next", opts.Next)
+			params.Set("previous", opts.Previous)
+		}
+		if opts.PerPage > 0 {
+			params.Set("perPage", strconv.Itoa(opts.PerPage))
+		}
+	}
+
+	banks := &List{}
+	err := s.Client.Call(ctx, http.MethodGet, client.AddQuery("/bank", params), nil, banks)
+	return banks, err
+}
+
 // ResolveBVN docs https://developers.paystack.co/v1.0/reference#resolve-bvn
 func (s *DefaultBankService) ResolveBVN(ctx context.Context, bvn int) (*BVNResponse, error) {
 	u := fmt.Sprintf("/bank/resolve_bvn/%d", bvn)
@@ -2,8 +2,12 @@ package bank
 
 import (
 	"context"
+	"encoding/json"
 	"github.com/hub1989/paystack-api-wrapper/client"
 	"github.com/hub1989/paystack-api-wrapper/configuration"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 )
 
@@ -25,6 +29,19 @@ func TestBankList(t *testing.T) {
 	}
 }
 
+func TestBankListWithOptions(t *testing.T) {
+	banks, err := service.ListWithOptions(context.TODO(), &ListOptions{Country: "ghana", Type: TypeMobileMoney})
+	if err != nil || !(len(banks.Values) > 0) {
+		t.Errorf("Expected Bank list, got %d, returned error %v", len(banks.Values), err)
+	}
+
+	for _, b := range banks.Values {
+		if b.Type != TypeMobileMoney {
+			t.Errorf("Expected %s bank, got %+v", TypeMobileMoney, b)
+		}
+	}
+}
+
 func TestResolveBVN(t *testing.T) {
 	// Test invlaid BVN.
 	// Err not nill. Resp status code is 400
@@ -59,3 +76,56 @@ func TestResolveAccountNumber(t *testing.T) {
 		}
 	*/
 }
+
+func TestBankListWithCursor(t *testing.T) {
+	pages := map[string]map[string]interface{}{
+		"": {
+			"data": []interface{}{map[string]interface{}{"name": "Access Bank", "code": "044"}},
+			"meta": map[string]interface{}{"next": "YmFuazoy", "previous": nil, "perPage": 1},
+		},
+		"YmFuazoy": {
+			"data": []interface{}{map[string]interface{}{"name": "Zenith Bank", "code": "057"}},
+			"meta": map[string]interface{}{"next": nil, "previous": "YmFuazox", "perPage": 1},
+		},
+	}
+
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		page := pages[r.URL.Query().Get("next")]
+		page["status"] = true
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	t.Cleanup(server.Close)
+
+	cursorClient := configuration.NewClient("sk_test", server.Client(), false)
+	cursorClient.BaseURL, _ = url.Parse(server.URL)
+	cursorService := &DefaultBankService{Client: cursorClient}
+
+	var names []string
+	opts := &ListOptions{Country: "nigeria", UseCursor: true, PerPage: 1}
+	for {
+		banks, err := cursorService.ListWithOptions(context.TODO(), opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, b := range banks.Values {
+			names = append(names, b.Name)
+		}
+		if banks.Meta.Next == "" {
+			if banks.Meta.Previous != "YmFuazox" {
+				t.Errorf("Expected the previous cursor of the last page, got %q", banks.Meta.Previous)
+			}
+			break
+		}
+		opts.Next = banks.Meta.Next
+	}
+
+	if len(names) != 2 || names[1] != "Zenith Bank" {
+		t.Errorf("Expected to page through 2 banks, got %v", names)
+	}
+
+	if queries[0].Get("use_cursor") != "true" || queries[1].Get("next") != "YmFuazoy" {
+		t.Errorf("Expected cursor pagination queries, got %v", queries)
+	}
+}
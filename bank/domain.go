@@ -2,18 +2,49 @@ package bank
 
 import "github.com/hub1989/paystack-api-wrapper/response"
 
+// Type is the kind of account a bank holds
+type Type string
+
+const (
+	TypeNUBAN       Type = "nuban"
+	TypeMobileMoney Type = "mobile_money"
+	TypeGHIPSS      Type = "ghipss"
+	TypeKEPSS       Type = "kepss"
+	TypeBASA        Type = "basa"
+)
+
 // Bank represents a Paystack bank
 type Bank struct {
-	ID        int    `json:"id,omitempty"`
-	CreatedAt string `json:"createdAt,omitempty"`
-	UpdatedAt string `json:"updatedAt,omitempty"`
-	Name      string `json:"name,omitempty"`
-	Slug      string `json:"slug,omitempty"`
-	Code      string `json:"code,omitempty"`
-	LongCode  string `json:"long_code,omitempty"`
-	Gateway   string `json:"gateway,omitempty"`
-	Active    bool   `json:"active,omitempty"`
-	IsDeleted bool   `json:"is_deleted,omitempty"`
+	ID               int    `json:"id,omitempty"`
+	CreatedAt        string `json:"createdAt,omitempty"`
+	UpdatedAt        string `json:"updatedAt,omitempty"`
+	Name             string `json:"name,omitempty"`
+	Slug             string `json:"slug,omitempty"`
+	Code             string `json:"code,omitempty"`
+	LongCode         string `json:"longcode,omitempty"`
+	Gateway          string `json:"gateway,omitempty"`
+	Country          string `json:"country,omitempty"`
+	Currency         string `json:"currency,omitempty"`
+	Type             Type   `json:"type,omitempty"`
+	PayWithBank      bool   `json:"pay_with_bank,omitempty"`
+	SupportsTransfer bool   `json:"supports_transfer,omitempty"`
+	Active           bool   `json:"active,omitempty"`
+	IsDeleted        bool   `json:"is_deleted,omitempty"`
+}
+
+// ListOptions filters the banks returned by ListWithOptions.
+// Country is the full country name in lower case, e.g. nigeria, ghana, kenya or south africa.
+type ListOptions struct {
+	Country             string
+	Currency            string
+	Type                Type
+	PayWithBank         bool
+	PayWithBankTransfer bool
+	// UseCursor switches to cursor pagination; pass the Meta.Next or Meta.Previous cursor of the last page
+	UseCursor bool
+	Next      string
+	Previous  string
+	PerPage   int
 }
 
 // List is a list object for banks.
@@ -0,0 +1,95 @@
+package bank
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidAccountNumber is returned when an account number cannot belong to the given bank
+var ErrInvalidAccountNumber = errors.New("bank: invalid account number")
+
+// nubanWeights are the CBN weights applied to the 6 digit institution code followed by the 9 digit serial number
+var nubanWeights = [15]int{3, 7, 3, 3, 7, 3, 3, 7, 3, 3, 7, 3, 3, 7, 3}
+
+// depositMoneyBanks are the Paystack codes of the deposit money banks, whose account numbers are issued
+// under their CBN code. Other banks, including microfinance banks with 3 digit Paystack codes such as
+// 565 (Carbon), 566 (VFD) or 125 (Rubies), issue account numbers under a 6 digit NIP code Paystack does not return.
+var depositMoneyBanks = map[string]bool{
+	"011": true, // First Bank of Nigeria
+	"023": true, // Citibank
+	"030": true, // Heritage Bank
+	"032": true, // Union Bank
+	"033": true, // United Bank for Africa
+	"035": true, // Wema Bank
+	"044": true, // Access Bank
+	"050": true, // Ecobank
+	"057": true, // Zenith Bank
+	"058": true, // Guaranty Trust Bank
+	"063": true, // Access Bank (Diamond)
+	"068": true, // Standard Chartered Bank
+	"070": true, // Fidelity Bank
+	"076": true, // Polaris Bank
+	"082": true, // Keystone Bank
+	"100": true, // Suntrust Bank
+	"101": true, // Providus Bank
+	"102": true, // Titan Trust Bank
+	"103": true, // Globus Bank
+	"214": true, // First City Monument Bank
+	"215": true, // Unity Bank
+	"221": true, // Stanbic IBTC Bank
+	"232": true, // Sterling Bank
+	"301": true, // Jaiz Bank
+}
+
+// ValidateNUBAN checks a Nigerian account number against the CBN NUBAN check digit.
+// Only deposit money bank codes are checked; for other codes, such as microfinance banks and wallets,
+// only the account number format is checked.
+func ValidateNUBAN(accountNumber, bankCode string) error {
+	if len(accountNumber) != 10 || !isDigits(accountNumber) {
+		return fmt.Errorf("%w: %q must be 10 digits", ErrInvalidAccountNumber, accountNumber)
+	}
+
+	if !depositMoneyBanks[bankCode] {
+		return nil
+	}
+
+	check, err := NUBANCheckDigit("000"+bankCode, accountNumber[:9])
+	if err != nil {
+		return err
+	}
+	if int(accountNumber[9]-'0') != check {
+		return fmt.Errorf("%w: %s fails the NUBAN check for bank %s", ErrInvalidAccountNumber, accountNumber, bankCode)
+	}
+	return nil
+}
+
+// NUBANCheckDigit computes the check digit of a 9 digit serial number issued by a 6 digit institution code
+// For more details see the CBN revised standards on the Nigeria Uniform Bank Account Number (NUBAN)
+func NUBANCheckDigit(institutionCode, serial string) (int, error) {
+	if len(institutionCode) != 6 || !isDigits(institutionCode) {
+		return 0, fmt.Errorf("%w: institution code %q must be 6 digits", ErrInvalidAccountNumber, institutionCode)
+	}
+	if len(serial) != 9 || !isDigits(serial) {
+		return 0, fmt.Errorf("%w: serial number %q must be 9 digits", ErrInvalidAccountNumber, serial)
+	}
+
+	sum := 0
+	for i, d := range institutionCode + serial {
+		sum += int(d-'0') * nubanWeights[i]
+	}
+
+	check := 10 - sum%10
+	if check == 10 {
+		check = 0
+	}
+	return check, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
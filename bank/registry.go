@@ -0,0 +1,288 @@
+package bank
+
+import (
+	"context"
+	"fmt"
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// registryPageSize is the number of banks requested per page when loading the registry
+const registryPageSize = 100
+
+// Registry is a local copy of the Paystack bank list.
+// It answers bank lookups and validates account numbers without a network call.
+// A Registry is safe for concurrent use.
+type Registry struct {
+	Service Service
+	// OnError is called when a scheduled refresh fails. The registry keeps its previous banks.
+	OnError func(err error)
+
+	mu        sync.RWMutex
+	banks     []Bank
+	byCode    map[string]int
+	bySlug    map[string]int
+	updatedAt time.Time
+}
+
+// NewRegistry creates an empty registry loading banks from service. Call Refresh or Start to load it.
+func NewRegistry(service Service) *Registry {
+	return &Registry{Service: service}
+}
+
+// Refresh reloads every bank from Paystack
+func (r *Registry) Refresh(ctx context.Context) error {
+	var banks []Bank
+	seen := map[string]bool{}
+
+	for page := 1; ; page++ {
+		list, err := r.Service.ListN(ctx, registryPageSize, page)
+		if err != nil {
+			return err
+		}
+
+		added := 0
+		for _, b := range list.Values {
+			key := b.Code + "/" + b.Slug
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			banks = append(banks, b)
+			added++
+		}
+
+		// stop on a short page, or when pagination is ignored and the same banks come back again
+		if len(list.Values) < registryPageSize || added == 0 || (list.Meta.PageCount > 0 && page >= list.Meta.PageCount) {
+			break
+		}
+	}
+
+	r.load(banks)
+	return nil
+}
+
+// Start loads the registry and refreshes it every interval until ctx is done.
+// Only the error of the initial load is returned; later failures are passed to OnError.
+func (r *Registry) Start(ctx context.Context, interval time.Duration) error {
+	if err := r.Refresh(ctx); err != nil {
+		return err
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := r.Refresh(ctx); err != nil && r.OnError != nil {
+					r.OnError(err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// UpdatedAt returns when the registry was last loaded
+func (r *Registry) UpdatedAt() time.Time {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.updatedAt
+}
+
+// Banks returns every bank in the registry
+func (r *Registry) Banks() []Bank {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	banks := make([]Bank, len(r.banks))
+	copy(banks, r.banks)
+	return banks
+}
+
+// ByCode returns the bank with the given code
+func (r *Registry) ByCode(code string) (Bank, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	i, ok := r.byCode[code]
+	if !ok {
+		return Bank{}, false
+	}
+	return r.banks[i], true
+}
+
+// BySlug returns the bank with the given slug
+func (r *Registry) BySlug(slug string) (Bank, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	i, ok := r.bySlug[strings.ToLower(slug)]
+	if !ok {
+		return Bank{}, false
+	}
+	return r.banks[i], true
+}
+
+// ByName returns the bank whose name best matches name.
+// Exact matches win, then names containing the query, then the closest name by edit distance.
+// Case, punctuation and words like "bank", "microfinance", "plc" and "limited" are ignored.
+func (r *Registry) ByName(name string) (Bank, bool) {
+	query := normalizeName(name)
+	if query == "" {
+		return Bank{}, false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	best, bestScore := -1, 0
+	for i, b := range r.banks {
+		candidate := normalizeName(b.Name)
+		if candidate == "" {
+			continue
+		}
+
+		var score int
+		switch {
+		case candidate == query:
+			return b, true
+		case strings.Contains(candidate, query) || strings.Contains(query, candidate):
+			// prefer the candidate whose length is closest to the query
+			score = 1000 - abs(len(candidate)-len(query))
+		default:
+			distance := levenshtein(candidate, query)
+			if distance > maxDistance(query) {
+				continue
+			}
+			score = 100 - distance
+		}
+
+		if best == -1 || score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+
+	if best == -1 {
+		return Bank{}, false
+	}
+	return r.banks[best], true
+}
+
+// ValidateAccount checks that the bank code is known and, for Nigerian NUBAN banks, that the account number passes ValidateNUBAN
+func (r *Registry) ValidateAccount(accountNumber, bankCode string) error {
+	b, ok := r.ByCode(bankCode)
+	if !ok {
+		return fmt.Errorf("%w: unknown bank code %q", ErrInvalidAccountNumber, bankCode)
+	}
+
+	if (b.Country == "" || strings.EqualFold(b.Country, "nigeria")) && (b.Type == "" || b.Type == TypeNUBAN) {
+		return ValidateNUBAN(accountNumber, bankCode)
+	}
+	return nil
+}
+
+// ResolveAccountNumber validates the account locally before resolving it with Paystack
+func (r *Registry) ResolveAccountNumber(ctx context.Context, accountNumber, bankCode string) (response.Response, error) {
+	if err := r.ValidateAccount(accountNumber, bankCode); err != nil {
+		return nil, err
+	}
+	return r.Service.ResolveAccountNumber(ctx, accountNumber, bankCode)
+}
+
+func (r *Registry) load(banks []Bank) {
+	byCode := make(map[string]int, len(banks))
+	bySlug := make(map[string]int, len(banks))
+	for i, b := range banks {
+		if _, ok := byCode[b.Code]; !ok && b.Code != "" {
+			byCode[b.Code] = i
+		}
+		if b.Slug != "" {
+			bySlug[strings.ToLower(b.Slug)] = i
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.banks = banks
+	r.byCode = byCode
+	r.bySlug = bySlug
+	r.updatedAt = time.Now()
+}
+
+var ignoredNameWords = map[string]bool{
+	"bank":         true,
+	"microfinance": true,
+	"mfb":          true,
+	"plc":          true,
+	"limited":      true,
+	"ltd":          true,
+	"the":          true,
+}
+
+// normalizeName lowercases name and strips punctuation and generic words
+func normalizeName(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	kept := words[:0]
+	for _, w := range words {
+		if !ignoredNameWords[w] {
+			kept = append(kept, w)
+		}
+	}
+	return strings.Join(kept, " ")
+}
+
+func maxDistance(query string) int {
+	if d := len(query) / 4; d > 2 {
+		return d
+	}
+	return 2
+}
+
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
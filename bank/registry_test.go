@@ -0,0 +1,115 @@
+package bank
+
+import (
+	"context"
+	"errors"
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"testing"
+)
+
+type fakeBankService struct {
+	Service
+	banks    []Bank
+	resolves int
+}
+
+func (f *fakeBankService) ListN(ctx context.Context, count, offset int) (*List, error) {
+	start := (offset - 1) * count
+	if start > len(f.banks) {
+		start = len(f.banks)
+	}
+	end := start + count
+	if end > len(f.banks) {
+		end = len(f.banks)
+	}
+	return &List{Values: f.banks[start:end]}, nil
+}
+
+func (f *fakeBankService) ResolveAccountNumber(ctx context.Context, accountNumber, bankCode string) (response.Response, error) {
+	f.resolves++
+	return response.Response{"account_number": accountNumber}, nil
+}
+
+func newTestRegistry(t *testing.T) (*Registry, *fakeBankService) {
+	fake := &fakeBankService{banks: []Bank{
+		{Name: "Access Bank", Slug: "access-bank", Code: "044"},
+		{Name: "Guaranty Trust Bank", Slug: "guaranty-trust-bank", Code: "058"},
+		{Name: "First Bank of Nigeria", Slug: "first-bank-of-nigeria", Code: "011"},
+		{Name: "Kuda Bank", Slug: "kuda-bank", Code: "50211"},
+		{Name: "Carbon", Slug: "carbon", Code: "565"},
+	}}
+	for i := 0; i < 150; i++ {
+		fake.banks = append(fake.banks, Bank{Name: "Microfinance", Code: "9" + string(rune('a'+i%26)) + string(rune('a'+i/26))})
+	}
+
+	registry := NewRegistry(fake)
+	if err := registry.Refresh(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	return registry, fake
+}
+
+func TestRegistryLookup(t *testing.T) {
+	registry, fake := newTestRegistry(t)
+
+	if len(registry.Banks()) != len(fake.banks) {
+		t.Errorf("Expected %d banks across pages, got %d", len(fake.banks), len(registry.Banks()))
+	}
+
+	if b, ok := registry.ByCode("058"); !ok || b.Slug != "guaranty-trust-bank" {
+		t.Errorf("Expected GTBank by code, got %+v", b)
+	}
+
+	if b, ok := registry.BySlug("Access-Bank"); !ok || b.Code != "044" {
+		t.Errorf("Expected Access Bank by slug, got %+v", b)
+	}
+
+	names := map[string]string{
+		"access":                  "044",
+		"Guaranty Trust Bank PLC": "058",
+		"guarranty trust":         "058",
+		"First Bank":              "011",
+		"KUDA MICROFINANCE BANK":  "50211",
+	}
+	for name, code := range names {
+		if b, ok := registry.ByName(name); !ok || b.Code != code {
+			t.Errorf("Expected %q to match bank %s, got %+v", name, code, b)
+		}
+	}
+
+	if b, ok := registry.ByName("zenith"); ok {
+		t.Errorf("Expected no match for zenith, got %+v", b)
+	}
+}
+
+func TestRegistryValidateAccount(t *testing.T) {
+	registry, fake := newTestRegistry(t)
+
+	if err := registry.ValidateAccount("0001234560", "058"); err != nil {
+		t.Errorf("Expected valid NUBAN, got %v", err)
+	}
+
+	if _, err := registry.ResolveAccountNumber(context.TODO(), "0001234561", "058"); !errors.Is(err, ErrInvalidAccountNumber) {
+		t.Errorf("Expected invalid NUBAN, got %v", err)
+	}
+
+	if _, err := registry.ResolveAccountNumber(context.TODO(), "0001234560", "999"); !errors.Is(err, ErrInvalidAccountNumber) {
+		t.Errorf("Expected unknown bank code, got %v", err)
+	}
+
+	if fake.resolves != 0 {
+		t.Errorf("Expected invalid accounts not to be resolved, got %d calls", fake.resolves)
+	}
+
+	if _, err := registry.ResolveAccountNumber(context.TODO(), "2000000001", "50211"); err != nil || fake.resolves != 1 {
+		t.Errorf("Expected non-NUBAN bank code to be resolved, got %v", err)
+	}
+
+	// a microfinance bank with a 3 digit code issues accounts under its NIP code, so the check digit is not checked
+	if err := registry.ValidateAccount("0001234561", "565"); err != nil {
+		t.Errorf("Expected microfinance bank account not to be checked against its Paystack code, got %v", err)
+	}
+	if err := registry.ValidateAccount("12345", "565"); !errors.Is(err, ErrInvalidAccountNumber) {
+		t.Errorf("Expected a malformed account number to be invalid, got %v", err)
+	}
+}
@@ -0,0 +1,154 @@
+package charge
+
+import (
+	"context"
+	"fmt"
+	"github.com/hub1989/paystack-api-wrapper/client"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CardBrand is the card scheme detected from a card number
+type CardBrand string
+
+const (
+	BrandUnknown    CardBrand = "unknown"
+	BrandVisa       CardBrand = "visa"
+	BrandMastercard CardBrand = "mastercard"
+	BrandVerve      CardBrand = "verve"
+	BrandAmex       CardBrand = "american express"
+	BrandDiscover   CardBrand = "discover"
+)
+
+// BINResolver looks up the issuer details of a card BIN. *client.Client implements it.
+type BINResolver interface {
+	ResolveCardBIN(ctx context.Context, bin string) (*client.CardBIN, error)
+}
+
+// Validate checks the card number, expiry date and CVV without calling Paystack
+func (c *Card) Validate() error {
+	number := c.number()
+	if len(number) < 12 || len(number) > 19 || !isDigits(number) {
+		return fmt.Errorf("%w: card number must be 12 to 19 digits", ErrInvalidRequest)
+	}
+	if !LuhnValid(number) {
+		return fmt.Errorf("%w: card number %s fails the Luhn check", ErrInvalidRequest, c.Masked())
+	}
+
+	if c.CVV != "" && (len(c.CVV) < 3 || len(c.CVV) > 4 || !isDigits(c.CVV)) {
+		return fmt.Errorf("%w: card cvv must be 3 or 4 digits", ErrInvalidRequest)
+	}
+
+	expiry, err := c.Expiry()
+	if err != nil {
+		return err
+	}
+	if time.Now().After(expiry) {
+		return fmt.Errorf("%w: card expired %s/%s", ErrInvalidRequest, c.ExpirtyMonth, c.ExpiryYear)
+	}
+	return nil
+}
+
+// Expiry returns the end of the card's expiry month
+func (c *Card) Expiry() (time.Time, error) {
+	month, err := strconv.Atoi(c.ExpirtyMonth)
+	if err != nil || month < 1 || month > 12 {
+		return time.Time{}, fmt.Errorf("%w: card expiry month %q must be between 01 and 12", ErrInvalidRequest, c.ExpirtyMonth)
+	}
+
+	year, err := strconv.Atoi(c.ExpiryYear)
+	if err != nil || (len(c.ExpiryYear) != 2 && len(c.ExpiryYear) != 4) {
+		return time.Time{}, fmt.Errorf("%w: card expiry year %q must have 2 or 4 digits", ErrInvalidRequest, c.ExpiryYear)
+	}
+	if len(c.ExpiryYear) == 2 {
+		year += 2000
+	}
+
+	// cards are valid until the last moment of the expiry month
+	return time.Date(year, time.Month(month)+1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond), nil
+}
+
+// BIN returns the first six digits of the card number
+func (c *Card) BIN() string {
+	number := c.number()
+	if len(number) < 6 {
+		return number
+	}
+	return number[:6]
+}
+
+// Brand detects the card scheme from the card number
+func (c *Card) Brand() CardBrand {
+	number := c.number()
+	prefix := func(n int) int {
+		if len(number) < n {
+			return -1
+		}
+		p, _ := strconv.Atoi(number[:n])
+		return p
+	}
+
+	// Verve's published range starts at 506099, but Nigerian issuers (and Paystack's test cards) also use 5060xx
+	switch p6 := prefix(6); {
+	case (p6 >= 506000 && p6 <= 506198) || (p6 >= 650002 && p6 <= 650027) || (p6 >= 507865 && p6 <= 507964):
+		return BrandVerve
+	case strings.HasPrefix(number, "4"):
+		return BrandVisa
+	case (prefix(2) >= 51 && prefix(2) <= 55) || (prefix(4) >= 2221 && prefix(4) <= 2720):
+		return BrandMastercard
+	case prefix(2) == 34 || prefix(2) == 37:
+		return BrandAmex
+	case prefix(4) == 6011 || prefix(2) == 65 || (prefix(3) >= 644 && prefix(3) <= 649):
+		return BrandDiscover
+	}
+	return BrandUnknown
+}
+
+// Masked returns the card number with all but the BIN and last four digits hidden
+func (c *Card) Masked() string {
+	number := c.number()
+	if len(number) <= 10 {
+		return strings.Repeat("*", len(number))
+	}
+	return number[:6] + strings.Repeat("*", len(number)-10) + number[len(number)-4:]
+}
+
+// ResolveBIN validates the card and looks up its BIN.
+// Invalid cards are rejected without a call to Paystack.
+func (c *Card) ResolveBIN(ctx context.Context, resolver BINResolver) (*client.CardBIN, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return resolver.ResolveCardBIN(ctx, c.BIN())
+}
+
+// number returns the card number without spaces or dashes
+func (c *Card) number() string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(c.Number)
+}
+
+// LuhnValid reports whether number passes the Luhn checksum
+func LuhnValid(number string) bool {
+	if number == "" {
+		return false
+	}
+
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		d := int(number[i] - '0')
+		if d < 0 || d > 9 {
+			return false
+		}
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
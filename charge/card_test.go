@@ -0,0 +1,89 @@
+package charge
+
+import (
+	"context"
+	"errors"
+	"github.com/hub1989/paystack-api-wrapper/client"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type fakeBINResolver struct {
+	calls int
+}
+
+func (f *fakeBINResolver) ResolveCardBIN(ctx context.Context, bin string) (*client.CardBIN, error) {
+	f.calls++
+	return &client.CardBIN{BIN: bin, Brand: "Visa"}, nil
+}
+
+func TestCardValidate(t *testing.T) {
+	nextYear := strconv.Itoa(time.Now().Year() + 1)
+
+	card := &Card{Number: "4084 0840 8408 4081", CVV: "408", ExpirtyMonth: "12", ExpiryYear: nextYear}
+	if err := card.Validate(); err != nil {
+		t.Errorf("Expected test card to be valid, got %v", err)
+	}
+
+	if card.Brand() != BrandVisa {
+		t.Errorf("Expected brand %s, got %s", BrandVisa, card.Brand())
+	}
+
+	if card.Masked() != "408408******4081" {
+		t.Errorf("Expected masked card 408408******4081, got %s", card.Masked())
+	}
+
+	invalid := []*Card{
+		{Number: "4084084084084082", CVV: "408", ExpirtyMonth: "12", ExpiryYear: nextYear},
+		{Number: "4084084084084081", CVV: "40", ExpirtyMonth: "12", ExpiryYear: nextYear},
+		{Number: "4084084084084081", CVV: "408", ExpirtyMonth: "13", ExpiryYear: nextYear},
+		{Number: "4084084084084081", CVV: "408", ExpirtyMonth: "01", ExpiryYear: "2001"},
+	}
+	for _, c := range invalid {
+		if err := c.Validate(); !errors.Is(err, ErrInvalidRequest) {
+			t.Errorf("Expected card %+v to be invalid, got %v", c, err)
+		}
+	}
+}
+
+func TestCardBrand(t *testing.T) {
+	brands := map[string]CardBrand{
+		"5060666666666666666": BrandVerve,
+		"5078651234567890":    BrandVerve,
+		"5399838383838381":    BrandMastercard,
+		"2221000000000009":    BrandMastercard,
+		"378282246310005":     BrandAmex,
+		"6011111111111117":    BrandDiscover,
+		"9999999999999999":    BrandUnknown,
+	}
+	for number, brand := range brands {
+		card := &Card{Number: number}
+		if card.Brand() != brand {
+			t.Errorf("Expected %s to be %s, got %s", number, brand, card.Brand())
+		}
+	}
+}
+
+func TestCardResolveBINRejectsInvalidCard(t *testing.T) {
+	resolver := &fakeBINResolver{}
+
+	card := &Card{Number: "4084084084084082", ExpirtyMonth: "12", ExpiryYear: "99"}
+	if _, err := card.ResolveBIN(context.TODO(), resolver); !errors.Is(err, ErrInvalidRequest) {
+		t.Errorf("Expected invalid card error, got %v", err)
+	}
+
+	if resolver.calls != 0 {
+		t.Errorf("Expected no BIN lookup for an invalid card, got %d", resolver.calls)
+	}
+
+	card.Number = "4084084084084081"
+	bin, err := card.ResolveBIN(context.TODO(), resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bin.BIN != "408408" {
+		t.Errorf("Expected BIN 408408, got %s", bin.BIN)
+	}
+}
@@ -16,7 +16,13 @@ type Service interface {
 	SubmitOTP(ctx context.Context, otp, reference string) (response.Response, error)
 	SubmitPhone(ctx context.Context, phone, reference string) (response.Response, error)
 	SubmitBirthday(ctx context.Context, birthday, reference string) (response.Response, error)
+	SubmitAddress(ctx context.Context, address *Address, reference string) (response.Response, error)
 	CheckPending(ctx context.Context, reference string) (response.Response, error)
+	ChargeMobileMoney(ctx context.Context, req *ChargeRequest) (*MobileMoneyResponse, error)
+	ChargeUSSD(ctx context.Context, req *ChargeRequest) (*USSDResponse, error)
+	ChargeQR(ctx context.Context, req *ChargeRequest) (*QRResponse, error)
+	ChargeBankTransfer(ctx context.Context, req *ChargeRequest) (*BankTransferResponse, error)
+	ChargeEFT(ctx context.Context, req *ChargeRequest) (*EFTResponse, error)
 }
 
 // DefaultChargeService handles operations related to bulk charges
@@ -26,16 +32,76 @@ type DefaultChargeService struct {
 }
 
 // Create submits a charge request using card details or bank details or authorization code
+// The request is validated before it is sent.
 // For more details see https://developers.paystack.co/v1.0/reference#charge
 func (s *DefaultChargeService) Create(ctx context.Context, req *ChargeRequest) (response.Response, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	resp := response.Response{}
 	err := s.Client.Call(ctx, http.MethodPost, "/charge", req, &resp)
 	return resp, err
 }
 
+// ChargeMobileMoney charges a mobile money wallet
+// For more details see https://paystack.com/docs/payments/payment-channels/#mobile-money
+func (s *DefaultChargeService) ChargeMobileMoney(ctx context.Context, req *ChargeRequest) (*MobileMoneyResponse, error) {
+	resp := &MobileMoneyResponse{}
+	err := s.createChannel(ctx, req, req.MobileMoney != nil, "mobile_money", resp)
+	return resp, err
+}
+
+// ChargeUSSD starts a USSD charge. The response holds the code the customer has to dial.
+// For more details see https://paystack.com/docs/payments/payment-channels/#ussd
+func (s *DefaultChargeService) ChargeUSSD(ctx context.Context, req *ChargeRequest) (*USSDResponse, error) {
+	resp := &USSDResponse{}
+	err := s.createChannel(ctx, req, req.USSD != nil, "ussd", resp)
+	return resp, err
+}
+
+// ChargeQR starts a QR charge. The response holds the QR code to display.
+// For more details see https://paystack.com/docs/payments/payment-channels/#qr
+func (s *DefaultChargeService) ChargeQR(ctx context.Context, req *ChargeRequest) (*QRResponse, error) {
+	resp := &QRResponse{}
+	err := s.createChannel(ctx, req, req.QR != nil, "qr", resp)
+	return resp, err
+}
+
+// ChargeBankTransfer starts a pay-with-transfer charge. The response holds the account the customer pays into.
+// For more details see https://paystack.com/docs/payments/payment-channels/#pay-with-transfer
+func (s *DefaultChargeService) ChargeBankTransfer(ctx context.Context, req *ChargeRequest) (*BankTransferResponse, error) {
+	resp := &BankTransferResponse{}
+	err := s.createChannel(ctx, req, req.BankTransfer != nil, "bank_transfer", resp)
+	return resp, err
+}
+
+// ChargeEFT starts an EFT charge. The customer completes the payment at the returned URL.
+// For more details see https://paystack.com/docs/payments/payment-channels/#eft
+func (s *DefaultChargeService) ChargeEFT(ctx context.Context, req *ChargeRequest) (*EFTResponse, error) {
+	resp := &EFTResponse{}
+	err := s.createChannel(ctx, req, req.EFT != nil, "eft", resp)
+	return resp, err
+}
+
+func (s *DefaultChargeService) createChannel(ctx context.Context, req *ChargeRequest, set bool, channel string, v interface{}) error {
+	if !set {
+		return fmt.Errorf("%w: %s is required", ErrInvalidRequest, channel)
+	}
+
+	resp, err := s.Create(ctx, req)
+	if err != nil {
+		return err
+	}
+	return client.Decode(resp, v)
+}
+
 // Tokenize tokenizes payment instrument before a charge
+// The request is validated before it is sent.
 // For more details see https://developers.paystack.co/v1.0/reference#charge-tokenize
 func (s *DefaultChargeService) Tokenize(ctx context.Context, req *ChargeRequest) (response.Response, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 	resp := response.Response{}
 	err := s.Client.Call(ctx, http.MethodPost, "/charge/tokenize", req, &resp)
 	return resp, err
@@ -48,40 +114,55 @@ func (s *DefaultChargeService) SubmitPIN(ctx context.Context, pin, reference str
 	data.Add("pin", pin)
 	data.Add("reference", reference)
 	resp := response.Response{}
-	err := s.Client.Call(ctx, http.MethodPost, "/charge/submit_pin", data, &resp)
+	err := s.Client.Call(ctx, http.MethodPost, "/charge/submit_pin", response.RequestValues(data), &resp)
 	return resp, err
 }
 
 // SubmitOTP submits OTP to continue a charge
-// For more details see https://developers.paystack.co/v1.0/reference#submit-pin
+// For more details see https://developers.paystack.co/v1.0/reference#submit-otp
 func (s *DefaultChargeService) SubmitOTP(ctx context.Context, otp, reference string) (response.Response, error) {
 	data := url.Values{}
-	data.Add("pin", otp)
+	data.Add("otp", otp)
 	data.Add("reference", reference)
 	resp := response.Response{}
-	err := s.Client.Call(ctx, http.MethodPost, "/charge/submit_otp", data, &resp)
+	err := s.Client.Call(ctx, http.MethodPost, "/charge/submit_otp", response.RequestValues(data), &resp)
 	return resp, err
 }
 
 // SubmitPhone submits Phone when requested
-// For more details see https://developers.paystack.co/v1.0/reference#submit-pin
+// For more details see https://developers.paystack.co/v1.0/reference#submit-phone
 func (s *DefaultChargeService) SubmitPhone(ctx context.Context, phone, reference string) (response.Response, error) {
 	data := url.Values{}
-	data.Add("pin", phone)
+	data.Add("phone", phone)
 	data.Add("reference", reference)
 	resp := response.Response{}
-	err := s.Client.Call(ctx, http.MethodPost, "/charge/submit_phone", data, &resp)
+	err := s.Client.Call(ctx, http.MethodPost, "/charge/submit_phone", response.RequestValues(data), &resp)
 	return resp, err
 }
 
 // SubmitBirthday submits Birthday when requested
-// For more details see https://developers.paystack.co/v1.0/reference#submit-pin
+// For more details see https://developers.paystack.co/v1.0/reference#submit-birthday
 func (s *DefaultChargeService) SubmitBirthday(ctx context.Context, birthday, reference string) (response.Response, error) {
 	data := url.Values{}
-	data.Add("pin", birthday)
+	data.Add("birthday", birthday)
 	data.Add("reference", reference)
 	resp := response.Response{}
-	err := s.Client.Call(ctx, http.MethodPost, "/charge/submit_birthday", data, &resp)
+	err := s.Client.Call(ctx, http.MethodPost, "/charge/submit_birthday", response.RequestValues(data), &resp)
+	return resp, err
+}
+
+// SubmitAddress submits the billing address for address verification (AVS) when requested
+// For more details see https://paystack.com/docs/api/#charge-submit-address
+func (s *DefaultChargeService) SubmitAddress(ctx context.Context, address *Address, reference string) (response.Response, error) {
+	reqBody := struct {
+		*Address
+		Reference string `json:"reference"`
+	}{
+		Address:   address,
+		Reference: reference,
+	}
+	resp := response.Response{}
+	err := s.Client.Call(ctx, http.MethodPost, "/charge/submit_address", reqBody, &resp)
 	return resp, err
 }
 
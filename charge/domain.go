@@ -22,15 +22,147 @@ type BankAccount struct {
 	AccountNumber string `json:"account_number,omitempty"`
 }
 
-// ChargeRequest represents a Paystack charge request
+// MobileMoneyProvider is the mobile money operator a customer pays with
+type MobileMoneyProvider string
+
+const (
+	MobileMoneyMTN        MobileMoneyProvider = "mtn"
+	MobileMoneyAirtelTigo MobileMoneyProvider = "atl"
+	MobileMoneyVodafone   MobileMoneyProvider = "vod"
+	MobileMoneyMPesa      MobileMoneyProvider = "mpesa"
+)
+
+// MobileMoney is used as mobile_money in a charge request
+type MobileMoney struct {
+	Phone    string              `json:"phone,omitempty"`
+	Provider MobileMoneyProvider `json:"provider,omitempty"`
+}
+
+// USSD is used as ussd in a charge request.
+// Type is the bank's USSD short code, e.g. 737 for Guaranty Trust Bank
+type USSD struct {
+	Type string `json:"type,omitempty"`
+}
+
+// QR is used as qr in a charge request. Provider is e.g. "scan-to-pay" or "visa"
+type QR struct {
+	Provider string `json:"provider,omitempty"`
+}
+
+// BankTransfer is used as bank_transfer in a charge request.
+// AccountExpiresAt optionally sets when the temporary account stops accepting the transfer (ISO 8601)
+type BankTransfer struct {
+	AccountExpiresAt string `json:"account_expires_at,omitempty"`
+}
+
+// EFT is used as eft in a charge request. Provider is e.g. "ozow"
+type EFT struct {
+	Provider string `json:"provider,omitempty"`
+}
+
+// Address is submitted when a charge requires address verification (AVS)
+type Address struct {
+	Address string `json:"address,omitempty"`
+	City    string `json:"city,omitempty"`
+	State   string `json:"state,omitempty"`
+	ZipCode string `json:"zipcode,omitempty"`
+}
+
+// ChargeRequest represents a Paystack charge request.
+// Only one of the payment channels (Card, Bank, AuthorizationCode, MobileMoney, USSD, QR, BankTransfer, EFT) should be set.
 type ChargeRequest struct {
 	Email             string           `json:"email,omitempty"`
 	Amount            float32          `json:"amount,omitempty"`
+	Currency          string           `json:"currency,omitempty"`
 	Birthday          string           `json:"birthday,omitempty"`
 	Card              *Card            `json:"card,omitempty"`
 	Bank              *BankAccount     `json:"bank,omitempty"`
 	AuthorizationCode string           `json:"authorization_code,omitempty"`
+	MobileMoney       *MobileMoney     `json:"mobile_money,omitempty"`
+	USSD              *USSD            `json:"ussd,omitempty"`
+	QR                *QR              `json:"qr,omitempty"`
+	BankTransfer      *BankTransfer    `json:"bank_transfer,omitempty"`
+	EFT               *EFT             `json:"eft,omitempty"`
 	Pin               string           `json:"pin,omitempty"`
 	Metadata          *client.Metadata `json:"metadata,omitempty"`
 	Reference         string           `json:"reference,omitempty"`
+	SplitCode         string           `json:"split_code,omitempty"`
+}
+
+// Status is the state of a charge, as reported in the data.status field of a charge response
+type Status string
+
+const (
+	StatusSendPIN      Status = "send_pin"
+	StatusSendOTP      Status = "send_otp"
+	StatusSendPhone    Status = "send_phone"
+	StatusSendBirthday Status = "send_birthday"
+	StatusSendAddress  Status = "send_address"
+	StatusOpenURL      Status = "open_url"
+	StatusPayOffline   Status = "pay_offline"
+	StatusPending      Status = "pending"
+	StatusSuccess      Status = "success"
+	StatusFailed       Status = "failed"
+	StatusTimeout      Status = "timeout"
+
+	StatusPendingBankTransfer Status = "pending_bank_transfer"
+)
+
+// Terminal reports whether no further action can change the charge
+func (s Status) Terminal() bool {
+	return s == StatusSuccess || s == StatusFailed || s == StatusTimeout
+}
+
+// ChargeResponse is the typed form of the data returned by Create, the Submit* steps and CheckPending
+type ChargeResponse struct {
+	ID              int     `json:"id,omitempty"`
+	Reference       string  `json:"reference,omitempty"`
+	Status          Status  `json:"status,omitempty"`
+	DisplayText     string  `json:"display_text,omitempty"`
+	URL             string  `json:"url,omitempty"`
+	Message         string  `json:"message,omitempty"`
+	GatewayResponse string  `json:"gateway_response,omitempty"`
+	Amount          float32 `json:"amount,omitempty"`
+	Currency        string  `json:"currency,omitempty"`
+	Channel         string  `json:"channel,omitempty"`
+}
+
+// MobileMoneyResponse is the response to a mobile money charge.
+// Depending on the provider the customer either approves the charge on their phone (pay_offline) or sends an OTP.
+type MobileMoneyResponse struct {
+	ChargeResponse
+}
+
+// USSDResponse is the response to a USSD charge. The customer dials USSDCode to complete the payment.
+type USSDResponse struct {
+	ChargeResponse
+	USSDCode string `json:"ussd_code,omitempty"`
+}
+
+// QRResponse is the response to a QR charge. URL links to the QR image, QRCode holds the raw code.
+type QRResponse struct {
+	ChargeResponse
+	QRCode string `json:"qr_code,omitempty"`
+}
+
+// TransferBank is the bank holding the account a pay-with-transfer customer pays into
+type TransferBank struct {
+	ID   int    `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	Slug string `json:"slug,omitempty"`
+}
+
+// BankTransferResponse is the response to a pay-with-transfer charge.
+// The customer transfers the amount to AccountNumber before AccountExpiresAt.
+type BankTransferResponse struct {
+	ChargeResponse
+	AccountName      string       `json:"account_name,omitempty"`
+	AccountNumber    string       `json:"account_number,omitempty"`
+	Bank             TransferBank `json:"bank,omitempty"`
+	AccountExpiresAt string       `json:"account_expires_at,omitempty"`
+}
+
+// EFTResponse is the response to an EFT charge. The customer completes the payment at URL.
+type EFTResponse struct {
+	ChargeResponse
 }
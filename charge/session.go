@@ -0,0 +1,271 @@
+package charge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hub1989/paystack-api-wrapper/client"
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"time"
+)
+
+const (
+	// DefaultPollInterval is the first wait before checking a pending charge.
+	// Paystack recommends waiting 30 seconds or more.
+	DefaultPollInterval = 30 * time.Second
+
+	// DefaultMaxPollInterval caps the backoff between pending charge checks
+	DefaultMaxPollInterval = 5 * time.Minute
+)
+
+// ErrUnexpectedStep is returned when a Submit* call does not match the state of the charge
+var ErrUnexpectedStep = errors.New("charge: step not expected in current state")
+
+// Action is what has to happen next for a charge to progress
+type Action string
+
+const (
+	ActionNone           Action = "none"
+	ActionSubmitPIN      Action = "submit_pin"
+	ActionSubmitOTP      Action = "submit_otp"
+	ActionSubmitPhone    Action = "submit_phone"
+	ActionSubmitBirthday Action = "submit_birthday"
+	ActionSubmitAddress  Action = "submit_address"
+	ActionOpenURL        Action = "open_url"
+	ActionPayOffline     Action = "pay_offline"
+	ActionWait           Action = "wait"
+)
+
+// ChargeSession drives a charge from Create to a terminal state.
+// It tracks the status returned by each step, exposes the action the customer has to take next
+// and rejects Submit* calls that do not match the current status.
+// A ChargeSession is not safe for concurrent use.
+type ChargeSession struct {
+	Service Service
+
+	// PollInterval is the first wait between CheckPending calls. It doubles after every call, up to MaxPollInterval.
+	PollInterval    time.Duration
+	MaxPollInterval time.Duration
+
+	last  *ChargeResponse
+	after func(d time.Duration) <-chan time.Time
+}
+
+// NewChargeSession creates a charge session using the given charge service
+func NewChargeSession(service Service) *ChargeSession {
+	return &ChargeSession{
+		Service:         service,
+		PollInterval:    DefaultPollInterval,
+		MaxPollInterval: DefaultMaxPollInterval,
+		after:           time.After,
+	}
+}
+
+// Start creates the charge
+func (s *ChargeSession) Start(ctx context.Context, req *ChargeRequest) (*ChargeResponse, error) {
+	if s.last != nil {
+		return nil, fmt.Errorf("%w: charge %s already started", ErrUnexpectedStep, s.last.Reference)
+	}
+	return s.update(s.Service.Create(ctx, req))
+}
+
+// Response returns the last response received for the charge
+func (s *ChargeSession) Response() *ChargeResponse {
+	return s.last
+}
+
+// Reference returns the reference of the charge
+func (s *ChargeSession) Reference() string {
+	if s.last == nil {
+		return ""
+	}
+	return s.last.Reference
+}
+
+// Status returns the current status of the charge
+func (s *ChargeSession) Status() Status {
+	if s.last == nil {
+		return ""
+	}
+	return s.last.Status
+}
+
+// Done reports whether the charge has reached a terminal state
+func (s *ChargeSession) Done() bool {
+	return s.Status().Terminal()
+}
+
+// NextAction returns the action required to move the charge forward
+func (s *ChargeSession) NextAction() Action {
+	switch s.Status() {
+	case StatusSendPIN:
+		return ActionSubmitPIN
+	case StatusSendOTP:
+		return ActionSubmitOTP
+	case StatusSendPhone:
+		return ActionSubmitPhone
+	case StatusSendBirthday:
+		return ActionSubmitBirthday
+	case StatusSendAddress:
+		return ActionSubmitAddress
+	case StatusOpenURL:
+		return ActionOpenURL
+	case StatusPayOffline, StatusPendingBankTransfer:
+		return ActionPayOffline
+	case StatusPending:
+		return ActionWait
+	default:
+		return ActionNone
+	}
+}
+
+// DisplayText returns the message to show the customer for the next action.
+// Paystack's display_text is used when present.
+func (s *ChargeSession) DisplayText() string {
+	if s.last != nil && s.last.DisplayText != "" {
+		return s.last.DisplayText
+	}
+
+	switch s.NextAction() {
+	case ActionSubmitPIN:
+		return "Please enter your card PIN"
+	case ActionSubmitOTP:
+		return "Please enter the OTP sent to you"
+	case ActionSubmitPhone:
+		return "Please enter your phone number"
+	case ActionSubmitBirthday:
+		return "Please enter your date of birth"
+	case ActionSubmitAddress:
+		return "Please enter your billing address"
+	case ActionOpenURL:
+		return "Please complete the payment on the page that opens"
+	case ActionPayOffline:
+		return "Please complete the payment on your phone"
+	case ActionWait:
+		return "Your payment is being processed"
+	}
+
+	if s.last != nil {
+		if s.last.GatewayResponse != "" {
+			return s.last.GatewayResponse
+		}
+		return s.last.Message
+	}
+	return ""
+}
+
+// SubmitPIN submits the card PIN when the charge status is send_pin
+func (s *ChargeSession) SubmitPIN(ctx context.Context, pin string) (*ChargeResponse, error) {
+	if err := s.expect(ActionSubmitPIN); err != nil {
+		return nil, err
+	}
+	return s.update(s.Service.SubmitPIN(ctx, pin, s.Reference()))
+}
+
+// SubmitOTP submits the OTP when the charge status is send_otp
+func (s *ChargeSession) SubmitOTP(ctx context.Context, otp string) (*ChargeResponse, error) {
+	if err := s.expect(ActionSubmitOTP); err != nil {
+		return nil, err
+	}
+	return s.update(s.Service.SubmitOTP(ctx, otp, s.Reference()))
+}
+
+// SubmitPhone submits the phone number when the charge status is send_phone
+func (s *ChargeSession) SubmitPhone(ctx context.Context, phone string) (*ChargeResponse, error) {
+	if err := s.expect(ActionSubmitPhone); err != nil {
+		return nil, err
+	}
+	return s.update(s.Service.SubmitPhone(ctx, phone, s.Reference()))
+}
+
+// SubmitBirthday submits the birthday (YYYY-MM-DD) when the charge status is send_birthday
+func (s *ChargeSession) SubmitBirthday(ctx context.Context, birthday string) (*ChargeResponse, error) {
+	if err := s.expect(ActionSubmitBirthday); err != nil {
+		return nil, err
+	}
+	return s.update(s.Service.SubmitBirthday(ctx, birthday, s.Reference()))
+}
+
+// SubmitAddress submits the billing address when the charge status is send_address
+func (s *ChargeSession) SubmitAddress(ctx context.Context, address *Address) (*ChargeResponse, error) {
+	if err := s.expect(ActionSubmitAddress); err != nil {
+		return nil, err
+	}
+	return s.update(s.Service.SubmitAddress(ctx, address, s.Reference()))
+}
+
+// Wait polls CheckPending, backing off between calls, until the charge reaches a terminal state
+// or needs input from the customer. It returns early with the context's error if ctx is done.
+func (s *ChargeSession) Wait(ctx context.Context) (*ChargeResponse, error) {
+	switch s.NextAction() {
+	case ActionWait, ActionOpenURL, ActionPayOffline:
+	case ActionNone:
+		if s.last == nil {
+			return nil, fmt.Errorf("%w: charge not started", ErrUnexpectedStep)
+		}
+		return s.last, nil
+	default:
+		return nil, fmt.Errorf("%w: status %s requires %s", ErrUnexpectedStep, s.Status(), s.NextAction())
+	}
+
+	interval := s.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return s.last, ctx.Err()
+		case <-s.wait(interval):
+		}
+
+		resp, err := s.update(s.Service.CheckPending(ctx, s.Reference()))
+		if err != nil {
+			return nil, err
+		}
+
+		switch s.NextAction() {
+		case ActionWait, ActionOpenURL, ActionPayOffline:
+		default:
+			return resp, nil
+		}
+
+		interval *= 2
+		if s.MaxPollInterval > 0 && interval > s.MaxPollInterval {
+			interval = s.MaxPollInterval
+		}
+	}
+}
+
+func (s *ChargeSession) expect(action Action) error {
+	if next := s.NextAction(); next != action {
+		return fmt.Errorf("%w: status %q requires %s, not %s", ErrUnexpectedStep, s.Status(), next, action)
+	}
+	return nil
+}
+
+func (s *ChargeSession) wait(d time.Duration) <-chan time.Time {
+	if s.after == nil {
+		return time.After(d)
+	}
+	return s.after(d)
+}
+
+// update records the result of a charge step
+func (s *ChargeSession) update(resp response.Response, err error) (*ChargeResponse, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	charge := &ChargeResponse{}
+	if err := client.Decode(resp, charge); err != nil {
+		return nil, err
+	}
+
+	if charge.Reference == "" && s.last != nil {
+		charge.Reference = s.last.Reference
+	}
+	s.last = charge
+	return charge, nil
+}
@@ -0,0 +1,119 @@
+package charge
+
+import (
+	"context"
+	"errors"
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"testing"
+	"time"
+)
+
+// fakeChargeService replays a fixed sequence of charge responses
+type fakeChargeService struct {
+	Service
+	responses []response.Response
+	calls     []string
+}
+
+func (f *fakeChargeService) next(call string) (response.Response, error) {
+	f.calls = append(f.calls, call)
+	resp := f.responses[0]
+	f.responses = f.responses[1:]
+	return resp, nil
+}
+
+func (f *fakeChargeService) Create(ctx context.Context, req *ChargeRequest) (response.Response, error) {
+	return f.next("create")
+}
+
+func (f *fakeChargeService) SubmitPIN(ctx context.Context, pin, reference string) (response.Response, error) {
+	return f.next("pin:" + reference)
+}
+
+func (f *fakeChargeService) SubmitOTP(ctx context.Context, otp, reference string) (response.Response, error) {
+	return f.next("otp:" + reference)
+}
+
+func (f *fakeChargeService) CheckPending(ctx context.Context, reference string) (response.Response, error) {
+	return f.next("pending:" + reference)
+}
+
+func TestChargeSessionFlow(t *testing.T) {
+	fake := &fakeChargeService{responses: []response.Response{
+		{"reference": "ref-1", "status": "send_pin"},
+		{"status": "send_otp", "display_text": "Enter the OTP sent to 080****1234"},
+		{"reference": "ref-1", "status": "pending"},
+		{"reference": "ref-1", "status": "pending"},
+		{"reference": "ref-1", "status": "success", "amount": 10000},
+	}}
+
+	var waits []time.Duration
+	session := NewChargeSession(fake)
+	session.PollInterval = time.Second
+	session.MaxPollInterval = time.Second
+	session.after = func(d time.Duration) <-chan time.Time {
+		waits = append(waits, d)
+		ch := make(chan time.Time, 1)
+		ch <- time.Now()
+		return ch
+	}
+
+	if _, err := session.Start(context.TODO(), &ChargeRequest{Email: "user@example.com", Amount: 10000}); err != nil {
+		t.Fatal(err)
+	}
+
+	if session.NextAction() != ActionSubmitPIN {
+		t.Errorf("Expected next action %s, got %s", ActionSubmitPIN, session.NextAction())
+	}
+
+	if _, err := session.SubmitOTP(context.TODO(), "123456"); !errors.Is(err, ErrUnexpectedStep) {
+		t.Errorf("Expected ErrUnexpectedStep submitting OTP for send_pin, got %v", err)
+	}
+
+	if _, err := session.SubmitPIN(context.TODO(), "1234"); err != nil {
+		t.Fatal(err)
+	}
+
+	if session.DisplayText() != "Enter the OTP sent to 080****1234" {
+		t.Errorf("Expected Paystack display text, got %q", session.DisplayText())
+	}
+
+	if _, err := session.SubmitOTP(context.TODO(), "123456"); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := session.Wait(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Status != StatusSuccess || !session.Done() || resp.Amount != 10000 {
+		t.Errorf("Expected successful charge, got %+v", resp)
+	}
+
+	if len(waits) != 2 || waits[1] != time.Second {
+		t.Errorf("Expected two capped waits, got %v", waits)
+	}
+
+	expected := []string{"create", "pin:ref-1", "otp:ref-1", "pending:ref-1", "pending:ref-1"}
+	for i, call := range expected {
+		if fake.calls[i] != call {
+			t.Errorf("Expected call %d to be %s, got %s", i, call, fake.calls[i])
+		}
+	}
+}
+
+func TestChargeSessionWaitNeedsInput(t *testing.T) {
+	fake := &fakeChargeService{responses: []response.Response{
+		{"reference": "ref-2", "status": "send_birthday"},
+	}}
+
+	session := NewChargeSession(fake)
+	if _, err := session.Start(context.TODO(), &ChargeRequest{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := session.Wait(context.TODO()); !errors.Is(err, ErrUnexpectedStep) {
+		t.Errorf("Expected ErrUnexpectedStep waiting on send_birthday, got %v", err)
+	}
+}
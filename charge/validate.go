@@ -0,0 +1,127 @@
+package charge
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrInvalidRequest is returned when a charge request fails validation before it is sent to Paystack
+var ErrInvalidRequest = errors.New("charge: invalid request")
+
+// Validate checks that at most one payment channel is set and that the channel has what Paystack needs
+func (r *ChargeRequest) Validate() error {
+	channels := r.channels()
+	if len(channels) > 1 {
+		return fmt.Errorf("%w: only one payment channel can be set, got %s", ErrInvalidRequest, strings.Join(channels, ", "))
+	}
+
+	if r.Card != nil {
+		if err := r.Card.Validate(); err != nil {
+			return err
+		}
+	}
+	if r.MobileMoney != nil {
+		if err := r.MobileMoney.Validate(); err != nil {
+			return err
+		}
+	}
+	if r.USSD != nil {
+		if err := r.USSD.Validate(); err != nil {
+			return err
+		}
+	}
+	if r.QR != nil && r.QR.Provider == "" {
+		return fmt.Errorf("%w: qr provider is required", ErrInvalidRequest)
+	}
+	if r.BankTransfer != nil {
+		if err := r.BankTransfer.Validate(); err != nil {
+			return err
+		}
+	}
+	if r.EFT != nil && r.EFT.Provider == "" {
+		return fmt.Errorf("%w: eft provider is required", ErrInvalidRequest)
+	}
+	return nil
+}
+
+// Validate checks the phone number and provider of a mobile money charge
+func (m *MobileMoney) Validate() error {
+	phone := strings.TrimPrefix(m.Phone, "+")
+	if phone == "" || !isDigits(phone) {
+		return fmt.Errorf("%w: mobile money phone %q must be a phone number", ErrInvalidRequest, m.Phone)
+	}
+
+	switch m.Provider {
+	case MobileMoneyMTN, MobileMoneyAirtelTigo, MobileMoneyVodafone, MobileMoneyMPesa:
+		return nil
+	case "":
+		return fmt.Errorf("%w: mobile money provider is required", ErrInvalidRequest)
+	default:
+		return fmt.Errorf("%w: unknown mobile money provider %q", ErrInvalidRequest, m.Provider)
+	}
+}
+
+// Validate checks the USSD type is a short code
+func (u *USSD) Validate() error {
+	if u.Type == "" || !isDigits(u.Type) {
+		return fmt.Errorf("%w: ussd type %q must be a bank USSD code, e.g. 737", ErrInvalidRequest, u.Type)
+	}
+	return nil
+}
+
+// Validate checks the account expiry, if set, is an ISO 8601 time in the future
+func (b *BankTransfer) Validate() error {
+	if b.AccountExpiresAt == "" {
+		return nil
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, b.AccountExpiresAt)
+	if err != nil {
+		return fmt.Errorf("%w: bank transfer account_expires_at: %v", ErrInvalidRequest, err)
+	}
+	if !expiresAt.After(time.Now()) {
+		return fmt.Errorf("%w: bank transfer account_expires_at %s is in the past", ErrInvalidRequest, b.AccountExpiresAt)
+	}
+	return nil
+}
+
+// channels returns the names of the payment channels set on the request
+func (r *ChargeRequest) channels() []string {
+	var channels []string
+	if r.Card != nil {
+		channels = append(channels, "card")
+	}
+	if r.Bank != nil {
+		channels = append(channels, "bank")
+	}
+	if r.AuthorizationCode != "" {
+		channels = append(channels, "authorization_code")
+	}
+	if r.MobileMoney != nil {
+		channels = append(channels, "mobile_money")
+	}
+	if r.USSD != nil {
+		channels = append(channels, "ussd")
+	}
+	if r.QR != nil {
+		channels = append(channels, "qr")
+	}
+	if r.BankTransfer != nil {
+		channels = append(channels, "bank_transfer")
+	}
+	if r.EFT != nil {
+		channels = append(channels, "eft")
+	}
+	return channels
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
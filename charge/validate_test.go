@@ -0,0 +1,46 @@
+package charge
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestChargeRequestValidate(t *testing.T) {
+	valid := []*ChargeRequest{
+		{Email: "user@example.com", Amount: 10000, Bank: &BankAccount{Code: "057", AccountNumber: "0000000000"}},
+		{Email: "user@example.com", Amount: 10000, Currency: "GHS", MobileMoney: &MobileMoney{Phone: "0551234987", Provider: MobileMoneyMTN}},
+		{Email: "user@example.com", Amount: 10000, USSD: &USSD{Type: "737"}},
+		{Email: "user@example.com", Amount: 10000, QR: &QR{Provider: "scan-to-pay"}},
+		{Email: "user@example.com", Amount: 10000, BankTransfer: &BankTransfer{}},
+		{Email: "user@example.com", Amount: 10000, Currency: "ZAR", EFT: &EFT{Provider: "ozow"}},
+	}
+	for _, req := range valid {
+		if err := req.Validate(); err != nil {
+			t.Errorf("Expected %v to be valid, got %v", req.channels(), err)
+		}
+	}
+
+	invalid := []*ChargeRequest{
+		{USSD: &USSD{Type: "737"}, QR: &QR{Provider: "scan-to-pay"}},
+		{MobileMoney: &MobileMoney{Phone: "0551234987"}},
+		{MobileMoney: &MobileMoney{Phone: "055-123", Provider: MobileMoneyMTN}},
+		{MobileMoney: &MobileMoney{Phone: "0551234987", Provider: "unknown"}},
+		{USSD: &USSD{Type: "*737#"}},
+		{QR: &QR{}},
+		{BankTransfer: &BankTransfer{AccountExpiresAt: "tomorrow"}},
+		{EFT: &EFT{}},
+	}
+	for _, req := range invalid {
+		if err := req.Validate(); !errors.Is(err, ErrInvalidRequest) {
+			t.Errorf("Expected %+v to be invalid, got %v", req, err)
+		}
+	}
+}
+
+func TestChargeChannelRequiresVariant(t *testing.T) {
+	_, err := service.ChargeUSSD(context.TODO(), &ChargeRequest{Email: "user@example.com", Amount: 10000})
+	if !errors.Is(err, ErrInvalidRequest) {
+		t.Errorf("Expected ErrInvalidRequest without ussd, got %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTL is how long cached lookups stay valid on a Client created by configuration.NewClient
+const DefaultCacheTTL = 5 * time.Minute
+
+// Cache is a small concurrency-safe TTL cache for API lookups.
+// A nil *Cache is valid and caches nothing.
+type Cache struct {
+	mu    sync.Mutex
+	ttl   time.Duration
+	items map[string]cacheItem
+	now   func() time.Time
+}
+
+type cacheItem struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// NewCache creates a cache whose entries expire after ttl.
+// A ttl of zero keeps entries until they are deleted.
+func NewCache(ttl time.Duration) *Cache {
+	return &Cache{
+		ttl:   ttl,
+		items: make(map[string]cacheItem),
+		now:   time.Now,
+	}
+}
+
+// Get returns the cached value for key, if present and not expired
+func (c *Cache) Get(key string) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	if !item.expiresAt.IsZero() && !c.now().Before(item.expiresAt) {
+		delete(c.items, key)
+		return nil, false
+	}
+	return item.value, true
+}
+
+// Set stores value under key
+func (c *Cache) Set(key string, value interface{}) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item := cacheItem{value: value}
+	if c.ttl > 0 {
+		item.expiresAt = c.now().Add(c.ttl)
+	}
+	c.items[key] = item
+}
+
+// Delete removes key from the cache
+func (c *Cache) Delete(key string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.items, key)
+}
+
+// Fetch returns the cached value for key, calling fn and caching its result on a miss.
+// Errors returned by fn are not cached.
+func (c *Cache) Fetch(key string, fn func() (interface{}, error)) (interface{}, error) {
+	if v, ok := c.Get(key); ok {
+		return v, nil
+	}
+
+	v, err := fn()
+	if err != nil {
+		return nil, err
+	}
+	c.Set(key, v)
+	return v, nil
+}
@@ -11,12 +11,14 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strconv"
+	"strings"
 )
 
 type Service interface {
 	Call(ctx context.Context, method, path string, body, v interface{}) error
-	ResolveCardBIN(ctx context.Context, bin int) (response.Response, error)
+	ResolveCardBIN(ctx context.Context, bin string) (*CardBIN, error)
 	CheckBalance(ctx context.Context) (response.Response, error)
 	GetSessionTimeout(ctx context.Context) (response.Response, error)
 	UpdateSessionTimeout(ctx context.Context, timeout int) (response.Response, error)
@@ -42,6 +44,9 @@ type Client struct {
 	Key            string
 	BaseURL        *url.URL
 	LoggingEnabled bool
+	// Cache holds lookups shared by the helpers built on this client, e.g. the expand package.
+	// It may be nil, in which case nothing is cached.
+	Cache *Cache
 }
 
 // Call actually does the HTTP request to Paystack API
@@ -91,22 +96,59 @@ func (c *Client) Call(ctx context.Context, method, path string, body, v interfac
 	return c.decodeResponse(resp, v)
 }
 
-// ResolveCardBIN docs https://developers.paystack.co/v1.0/reference#resolve-card-bin
-func (c *Client) ResolveCardBIN(ctx context.Context, bin int) (response.Response, error) {
-	u := fmt.Sprintf("/decision/bin/%d", bin)
-	resp := response.Response{}
-	err := c.Call(ctx, http.MethodGet, u, nil, &resp)
+// CardBIN holds the issuer details of a card BIN
+type CardBIN struct {
+	BIN          string `json:"bin,omitempty"`
+	Brand        string `json:"brand,omitempty"`
+	SubBrand     string `json:"sub_brand,omitempty"`
+	CountryCode  string `json:"country_code,omitempty"`
+	CountryName  string `json:"country_name,omitempty"`
+	CardType     string `json:"card_type,omitempty"`
+	Bank         string `json:"bank,omitempty"`
+	LinkedBankID int    `json:"linked_bank_id,omitempty"`
+}
 
-	return resp, err
+// ResolveCardBIN returns the issuer details of the first six digits of a card.
+// The bin is kept as a string so leading zeros are preserved. Results are cached on the client.
+// docs https://developers.paystack.co/v1.0/reference#resolve-card-bin
+func (c *Client) ResolveCardBIN(ctx context.Context, bin string) (*CardBIN, error) {
+	if len(bin) > 6 {
+		bin = bin[:6]
+	}
+	if len(bin) != 6 || strings.Trim(bin, "0123456789") != "" {
+		return nil, fmt.Errorf("paystack: card BIN %q must be the first 6 digits of the card", bin)
+	}
+
+	v, err := c.Cache.Fetch("bin:"+bin, func() (interface{}, error) {
+		resp := &CardBIN{}
+		err := c.Call(ctx, http.MethodGet, fmt.Sprintf("/decision/bin/%s", bin), nil, resp)
+		return resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := *v.(*CardBIN)
+	return &resp, nil
 }
 
-// CheckBalance docs https://developers.paystack.co/v1.0/reference#resolve-card-bin
+// CheckBalance returns the balance of the first currency of the integration.
+// An empty response is returned when the integration has no balance.
+// The balance package returns the balances of every currency.
+// docs https://developers.paystack.co/v1.0/reference#check-balance
 func (c *Client) CheckBalance(ctx context.Context) (response.Response, error) {
 	resp := response.Response{}
-	err := c.Call(ctx, http.MethodGet, "balance", nil, &resp)
+	if err := c.Call(ctx, http.MethodGet, "/balance", nil, &resp); err != nil {
+		return nil, err
+	}
+
 	// check balance 'data' node is an array
-	resp2 := resp["data"].([]interface{})[0].(map[string]interface{})
-	return resp2, err
+	balances, _ := resp["data"].([]interface{})
+	if len(balances) == 0 {
+		return response.Response{}, nil
+	}
+	balance, _ := balances[0].(map[string]interface{})
+	return balance, nil
 }
 
 // GetSessionTimeout fetches payment session timeout
@@ -134,6 +176,8 @@ func (c *Client) decodeResponse(httpResp *http.Response, v interface{}) error {
 	json.Unmarshal(respBody, &resp)
 
 	if status, _ := resp["status"].(bool); !status || httpResp.StatusCode >= 400 {
+		// NewAPIError reads the error details from the body
+		httpResp.Body = io.NopCloser(bytes.NewReader(respBody))
 		if c.LoggingEnabled {
 			log.WithError(err).Error("Paystack error")
 			log.WithFields(log.Fields{
@@ -162,11 +206,43 @@ func (c *Client) decodeResponse(httpResp *http.Response, v interface{}) error {
 	return mapstruct(resp, v)
 }
 
+// referenceHook decodes an embedded resource into a response.Reference field as the resource's ID.
+// Paystack returns some references, e.g. the plan of a subscription, as an ID from one endpoint
+// and as the whole resource from another.
+func referenceHook(from, to reflect.Type, data interface{}) (interface{}, error) {
+	if from.Kind() != reflect.Map || to != reflect.TypeOf(response.Reference("")) {
+		return data, nil
+	}
+
+	m, ok := data.(map[string]interface{})
+	if !ok {
+		return data, nil
+	}
+	switch id := m["id"].(type) {
+	case float64:
+		return strconv.FormatInt(int64(id), 10), nil
+	case int:
+		return strconv.Itoa(id), nil
+	case string:
+		return id, nil
+	}
+	return data, nil
+}
+
+// Decode decodes data, e.g. a response.Response, into v the way Call decodes responses:
+// fields are matched by their json tags and values are converted weakly, so "5000" decodes into a number.
+// It is meant for services that normalise a response before decoding it.
+func Decode(data interface{}, v interface{}) error {
+	return mapstruct(data, v)
+}
+
 func mapstruct(data interface{}, v interface{}) error {
 	config := &mapstructure.DecoderConfig{
 		Result:           v,
 		TagName:          "json",
 		WeaklyTypedInput: true,
+		Squash:           true,
+		DecodeHook:       referenceHook,
 	}
 	decoder, err := mapstructure.NewDecoder(config)
 	if err != nil {
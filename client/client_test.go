@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestCallErrorDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"status": false, "message": "Invalid key"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	u, _ := url.Parse(server.URL)
+	c := &Client{Client: server.Client(), Key: "sk_test", BaseURL: u}
+
+	err := c.Call(context.TODO(), http.MethodGet, "/bank", nil, &response.Response{})
+
+	var apiErr *response.APIError
+	if !errors.As(err, &apiErr) || apiErr.HTTPStatusCode != http.StatusBadRequest || apiErr.Details.Message != "Invalid key" {
+		t.Errorf("Expected the API error message to be read from the body, got %#v", err)
+	}
+}
@@ -2,7 +2,9 @@ package client
 
 import (
 	"fmt"
+	"net/url"
 	"os"
+	"strings"
 	"time"
 )
 
@@ -26,6 +28,24 @@ func PaginateURL(path string, count, offset int) string {
 	return fmt.Sprintf("%s?perPage=%d&page=%d", path, count, offset)
 }
 
+// AddQuery appends params to path as a query string, dropping empty values
+func AddQuery(path string, params url.Values) string {
+	for k, v := range params {
+		if len(v) == 0 || (len(v) == 1 && v[0] == "") {
+			delete(params, k)
+		}
+	}
+	if len(params) == 0 {
+		return path
+	}
+
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	return path + sep + params.Encode()
+}
+
 func MustGetTestKey() string {
 	key := os.Getenv("PAYSTACK_KEY")
 
@@ -21,6 +21,7 @@ func NewClient(key string, httpClient *http.Client, loggingEnabled bool) *client
 		Key:            key,
 		BaseURL:        u,
 		LoggingEnabled: loggingEnabled,
+		Cache:          client.NewCache(client.DefaultCacheTTL),
 	}
 	return c
 }
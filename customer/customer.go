@@ -7,17 +7,19 @@ import (
 	"github.com/hub1989/paystack-api-wrapper/response"
 	"net/http"
 	"net/url"
+	"strconv"
 )
 
 type Service interface {
 	Create(ctx context.Context, customer *Customer) (*Customer, error)
 	Update(ctx context.Context, customer *Customer) (*Customer, error)
-	Get(ctx context.Context, customerCode string) (*Customer, error)
+	Get(ctx context.Context, emailOrCode string) (*Customer, error)
 	List(ctx context.Context) (*List, error)
 	ListN(ctx context.Context, count, offset int) (*List, error)
-	SetRiskAction(ctx context.Context, customerCode, riskAction string) (*Customer, error)
+	ListWithOptions(ctx context.Context, opts *ListOptions) (*List, error)
+	SetRiskAction(ctx context.Context, customerCode string, riskAction RiskAction) (*Customer, error)
 	DeactivateAuthorization(ctx context.Context, authorizationCode string) (*response.Response, error)
-	ValidateCustomer(ctx context.Context, customerId string, request *ValidateCustomerRequest) (bool, error)
+	ValidateCustomer(ctx context.Context, customerCode string, request *ValidateCustomerRequest) (*IdentificationResult, error)
 }
 
 // DefaultCustomerService handles operations related to the customer
@@ -46,10 +48,10 @@ func (s *DefaultCustomerService) Update(ctx context.Context, customer *Customer)
 	return cust, err
 }
 
-// Get returns the details of a customer.
+// Get returns the details of a customer, given their email address or customer code.
 // For more details see https://paystack.com/docs/api/#customer-fetch
-func (s *DefaultCustomerService) Get(ctx context.Context, customerCode string) (*Customer, error) {
-	u := fmt.Sprintf("/customer/%s", customerCode)
+func (s *DefaultCustomerService) Get(ctx context.Context, emailOrCode string) (*Customer, error) {
+	u := fmt.Sprintf("/customer/%s", url.PathEscape(emailOrCode))
 	cust := &Customer{}
 	err := s.Client.Call(ctx, http.MethodGet, u, nil, cust)
 
@@ -71,12 +73,37 @@ func (s *DefaultCustomerService) ListN(ctx context.Context, count, offset int) (
 	return cust, err
 }
 
+// ListWithOptions returns the customers matching opts
+// For more details see https://paystack.com/docs/api/customer/#list
+func (s *DefaultCustomerService) ListWithOptions(ctx context.Context, opts *ListOptions) (*List, error) {
+	params := url.Values{}
+	if opts != nil {
+		params.Set("email", opts.Email)
+		params.Set("from", opts.From)
+		params.Set("to", opts.To)
+		if opts.PerPage > 0 {
+			params.Set("perPage", strconv.Itoa(opts.PerPage))
+		}
+		if opts.Page > 0 {
+			params.Set("page", strconv.Itoa(opts.Page))
+		}
+	}
+
+	cust := &List{}
+	err := s.Client.Call(ctx, http.MethodGet, client.AddQuery("/customer", params), nil, cust)
+	return cust, err
+}
+
 // SetRiskAction can be used to either whitelist or blacklist a customer
 // For more details see https://developers.paystack.co/v1.0/reference#whiteblacklist-customer
-func (s *DefaultCustomerService) SetRiskAction(ctx context.Context, customerCode, riskAction string) (*Customer, error) {
+func (s *DefaultCustomerService) SetRiskAction(ctx context.Context, customerCode string, riskAction RiskAction) (*Customer, error) {
+	if !riskAction.Valid() {
+		return nil, fmt.Errorf("%w %q", ErrInvalidRiskAction, riskAction)
+	}
+
 	reqBody := struct {
-		Customer    string `json:"customer"`
-		Risk_action string `json:"risk_action"`
+		Customer    string     `json:"customer"`
+		Risk_action RiskAction `json:"risk_action"`
 	}{
 		Customer:    customerCode,
 		Risk_action: riskAction,
@@ -99,14 +126,18 @@ func (s *DefaultCustomerService) DeactivateAuthorization(ctx context.Context, au
 	return resp, err
 }
 
-func (s *DefaultCustomerService) ValidateCustomer(ctx context.Context, customerId string, request *ValidateCustomerRequest) (bool, error) {
-	endpoint := fmt.Sprintf("/customer/%s/identification", customerId)
-	resp := &response.Response{}
+// ValidateCustomer starts the identification of a customer. Identification is asynchronous:
+// the returned result is pending, and an IdentificationWatcher can wait for the outcome.
+// For more details see https://paystack.com/docs/api/customer/#validate
+func (s *DefaultCustomerService) ValidateCustomer(ctx context.Context, customerCode string, request *ValidateCustomerRequest) (*IdentificationResult, error) {
+	endpoint := fmt.Sprintf("/customer/%s/identification", customerCode)
+	resp := response.Response{}
 
 	err := s.Client.Call(ctx, http.MethodPost, endpoint, request, &resp)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	return true, nil
+	message, _ := resp["message"].(string)
+	return &IdentificationResult{CustomerCode: customerCode, Status: IdentificationPending, Message: message}, nil
 }
@@ -68,7 +68,7 @@ func TestCustomerRiskAction(t *testing.T) {
 	customer1, _ := service.Create(context.TODO(), cust)
 
 	//TODO: investigate why 'allow' returns: 403 You cannot whitelist customers on this integration
-	customer, err := service.SetRiskAction(context.TODO(), customer1.CustomerCode, "deny")
+	customer, err := service.SetRiskAction(context.TODO(), customer1.CustomerCode, RiskActionDeny)
 	if err != nil {
 		t.Errorf("Customer risk action returned error %v", err)
 	}
@@ -4,6 +4,7 @@ import (
 	"github.com/hub1989/paystack-api-wrapper/client"
 	"github.com/hub1989/paystack-api-wrapper/response"
 	"github.com/hub1989/paystack-api-wrapper/subscription"
+	"github.com/hub1989/paystack-api-wrapper/transaction"
 )
 
 // Customer is the resource representing your Paystack customer.
@@ -21,8 +22,66 @@ type Customer struct {
 	Metadata       client.Metadata             `json:"metadata,omitempty"`
 	CustomerCode   string                      `json:"customer_code,omitempty"`
 	Subscriptions  []subscription.Subscription `json:"subscriptions,omitempty"`
-	Authorizations []interface{}               `json:"authorizations,omitempty"`
-	RiskAction     string                      `json:"risk_action"`
+	Authorizations []transaction.Authorization `json:"authorizations,omitempty"`
+	RiskAction     RiskAction                  `json:"risk_action"`
+	// Identified is set once the customer's identity has been validated
+	Identified      bool             `json:"identified,omitempty"`
+	Identifications []Identification `json:"identifications,omitempty"`
+	// DedicatedAccount is the dedicated virtual account assigned to the customer, returned by Get.
+	// The dedicatedaccount package decodes it.
+	DedicatedAccount interface{} `json:"dedicated_account,omitempty"`
+}
+
+// RiskAction whitelists or blacklists a customer
+type RiskAction string
+
+const (
+	// RiskActionDefault leaves the customer to the integration's fraud rules
+	RiskActionDefault RiskAction = "default"
+	// RiskActionAllow whitelists the customer
+	RiskActionAllow RiskAction = "allow"
+	// RiskActionDeny blacklists the customer
+	RiskActionDeny RiskAction = "deny"
+)
+
+// Valid reports whether a is a risk action known to Paystack
+func (a RiskAction) Valid() bool {
+	switch a {
+	case RiskActionDefault, RiskActionAllow, RiskActionDeny:
+		return true
+	}
+	return false
+}
+
+// Identification is a means of identification validated for a customer
+type Identification struct {
+	Country       string `json:"country,omitempty"`
+	Type          string `json:"type,omitempty"`
+	Value         string `json:"value,omitempty"`
+	BVN           string `json:"bvn,omitempty"`
+	AccountNumber string `json:"account_number,omitempty"`
+	BankCode      string `json:"bank_code,omitempty"`
+}
+
+// IdentificationStatus is the state of a customer identification
+type IdentificationStatus string
+
+const (
+	IdentificationPending IdentificationStatus = "pending"
+	IdentificationSuccess IdentificationStatus = "success"
+	IdentificationFailed  IdentificationStatus = "failed"
+)
+
+// IdentificationResult is the outcome of a customer identification.
+// ValidateCustomer returns a pending result; the final outcome is delivered by the
+// customeridentification.success and customeridentification.failed webhook events.
+type IdentificationResult struct {
+	CustomerCode   string               `json:"customer_code,omitempty"`
+	Email          string               `json:"email,omitempty"`
+	Status         IdentificationStatus `json:"status,omitempty"`
+	Message        string               `json:"message,omitempty"`
+	Reason         string               `json:"reason,omitempty"`
+	Identification Identification       `json:"identification,omitempty"`
 }
 
 type ValidateCustomerRequest struct {
@@ -35,6 +94,16 @@ type ValidateCustomerRequest struct {
 	LastName      string `json:"last_name,omitempty"`
 }
 
+// ListOptions filters the customers returned by ListWithOptions
+type ListOptions struct {
+	Email string
+	// From and To limit the creation date range, e.g. 2016-09-21T00:00:00.000Z
+	From    string
+	To      string
+	PerPage int
+	Page    int
+}
+
 // List is a list object for customers.
 type List struct {
 	Meta   response.ListMeta
@@ -0,0 +1,224 @@
+package customer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Identification webhook events
+const (
+	EventIdentificationSuccess = "customeridentification.success"
+	EventIdentificationFailed  = "customeridentification.failed"
+)
+
+// IdentificationWatcher waits for the outcome of customer identifications.
+// Outcomes received from webhooks through HandleWebhook are handed to the callers waiting for them,
+// or kept for the next Wait; otherwise the customer is polled until Paystack reports them as identified.
+// Start identifications with Validate so an outcome of an earlier attempt is not mistaken for the new one.
+// An IdentificationWatcher is safe for concurrent use.
+type IdentificationWatcher struct {
+	Service Service
+	// PollInterval is the first wait between Get calls. It doubles after every call, up to MaxPollInterval.
+	PollInterval    time.Duration
+	MaxPollInterval time.Duration
+
+	mu      sync.Mutex
+	results map[string]*IdentificationResult
+	waiters map[string][]chan *IdentificationResult
+	after   func(d time.Duration) <-chan time.Time
+}
+
+// NewIdentificationWatcher creates a watcher polling customers with the given service
+func NewIdentificationWatcher(service Service) *IdentificationWatcher {
+	return &IdentificationWatcher{
+		Service:         service,
+		PollInterval:    2 * time.Second,
+		MaxPollInterval: 30 * time.Second,
+		after:           time.After,
+	}
+}
+
+// HandleWebhook records the outcome carried by a customeridentification webhook body.
+// It reports whether the body was an identification event.
+// For more details see https://paystack.com/docs/payments/webhooks/#supported-events
+func (w *IdentificationWatcher) HandleWebhook(body []byte) (bool, error) {
+	event := struct {
+		Event string `json:"event"`
+		Data  struct {
+			CustomerCode   string         `json:"customer_code"`
+			Email          string         `json:"email"`
+			Reason         string         `json:"reason"`
+			Identification Identification `json:"identification"`
+		} `json:"data"`
+	}{}
+	if err := json.Unmarshal(body, &event); err != nil {
+		return false, fmt.Errorf("customer: invalid webhook body: %w", err)
+	}
+
+	var status IdentificationStatus
+	switch event.Event {
+	case EventIdentificationSuccess:
+		status = IdentificationSuccess
+	case EventIdentificationFailed:
+		status = IdentificationFailed
+	default:
+		return false, nil
+	}
+
+	w.record(&IdentificationResult{
+		CustomerCode:   event.Data.CustomerCode,
+		Email:          event.Data.Email,
+		Status:         status,
+		Reason:         event.Data.Reason,
+		Identification: event.Data.Identification,
+	})
+	return true, nil
+}
+
+// Validate starts a new identification of the customer, discarding any outcome kept from an earlier attempt
+func (w *IdentificationWatcher) Validate(ctx context.Context, customerCode string, request *ValidateCustomerRequest) (*IdentificationResult, error) {
+	w.Forget(customerCode)
+	return w.Service.ValidateCustomer(ctx, customerCode, request)
+}
+
+// Forget discards the outcome kept for a customer
+func (w *IdentificationWatcher) Forget(customerCode string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.results, customerCode)
+}
+
+// Result returns the outcome kept for a customer, if any, without consuming it
+func (w *IdentificationWatcher) Result(customerCode string) (*IdentificationResult, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	res, ok := w.results[customerCode]
+	if !ok {
+		return nil, false
+	}
+	cp := *res
+	return &cp, true
+}
+
+// Wait blocks until the identification of the customer succeeds or fails, or ctx is done.
+// A kept outcome is consumed, so the next Wait waits for a new one.
+// A failure can only be learned from the customeridentification.failed webhook, so callers
+// that do not forward webhooks to HandleWebhook should bound ctx with a deadline.
+func (w *IdentificationWatcher) Wait(ctx context.Context, customerCode string) (*IdentificationResult, error) {
+	interval := w.PollInterval
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+
+	for {
+		notify, res := w.subscribe(customerCode)
+		if res != nil {
+			return res, nil
+		}
+
+		cust, err := w.Service.Get(ctx, customerCode)
+		if err != nil {
+			w.unsubscribe(customerCode, notify)
+			return nil, err
+		}
+		if cust.Identified {
+			w.unsubscribe(customerCode, notify)
+			res := &IdentificationResult{CustomerCode: customerCode, Email: cust.Email, Status: IdentificationSuccess}
+			if len(cust.Identifications) > 0 {
+				res.Identification = cust.Identifications[len(cust.Identifications)-1]
+			}
+			return res, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			w.unsubscribe(customerCode, notify)
+			return nil, ctx.Err()
+		case res := <-notify:
+			return res, nil
+		case <-w.wait(interval):
+			w.unsubscribe(customerCode, notify)
+		}
+
+		interval *= 2
+		if w.MaxPollInterval > 0 && interval > w.MaxPollInterval {
+			interval = w.MaxPollInterval
+		}
+	}
+}
+
+// record hands res to the callers waiting for it, or keeps it for the next Wait when there are none
+func (w *IdentificationWatcher) record(res *IdentificationResult) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	waiters := w.waiters[res.CustomerCode]
+	delete(w.waiters, res.CustomerCode)
+	for _, ch := range waiters {
+		cp := *res
+		ch <- &cp
+	}
+
+	if len(waiters) == 0 {
+		if w.results == nil {
+			w.results = map[string]*IdentificationResult{}
+		}
+		w.results[res.CustomerCode] = res
+	}
+}
+
+// subscribe consumes the outcome kept for the customer or, when there is none,
+// registers a channel the next outcome is sent to
+func (w *IdentificationWatcher) subscribe(customerCode string) (chan *IdentificationResult, *IdentificationResult) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if res, ok := w.results[customerCode]; ok {
+		delete(w.results, customerCode)
+		return nil, res
+	}
+
+	if w.waiters == nil {
+		w.waiters = map[string][]chan *IdentificationResult{}
+	}
+	ch := make(chan *IdentificationResult, 1)
+	w.waiters[customerCode] = append(w.waiters[customerCode], ch)
+	return ch, nil
+}
+
+// unsubscribe removes ch from the waiters. An outcome sent to ch in the meantime is kept for the next Wait.
+func (w *IdentificationWatcher) unsubscribe(customerCode string, ch chan *IdentificationResult) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	select {
+	case res := <-ch:
+		if w.results == nil {
+			w.results = map[string]*IdentificationResult{}
+		}
+		w.results[customerCode] = res
+	default:
+	}
+
+	waiters := w.waiters[customerCode]
+	for i, c := range waiters {
+		if c == ch {
+			w.waiters[customerCode] = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(w.waiters[customerCode]) == 0 {
+		delete(w.waiters, customerCode)
+	}
+}
+
+func (w *IdentificationWatcher) wait(d time.Duration) <-chan time.Time {
+	if w.after == nil {
+		return time.After(d)
+	}
+	return w.after(d)
+}
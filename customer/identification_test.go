@@ -0,0 +1,203 @@
+package customer
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hub1989/paystack-api-wrapper/configuration"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+type fakeService struct {
+	Service
+	gets        int
+	identified  int
+	validations int
+}
+
+func (f *fakeService) Get(ctx context.Context, emailOrCode string) (*Customer, error) {
+	f.gets++
+	cust := &Customer{CustomerCode: emailOrCode, Email: "user@example.com"}
+	if f.identified > 0 && f.gets >= f.identified {
+		cust.Identified = true
+		cust.Identifications = []Identification{{Country: "NG", Type: "bank_account", Value: "0123456789"}}
+	}
+	return cust, nil
+}
+
+func (f *fakeService) ValidateCustomer(ctx context.Context, customerCode string, request *ValidateCustomerRequest) (*IdentificationResult, error) {
+	f.validations++
+	return &IdentificationResult{CustomerCode: customerCode, Status: IdentificationPending}, nil
+}
+
+func newTestService(t *testing.T, handler http.HandlerFunc) *DefaultCustomerService {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c := configuration.NewClient("sk_test", server.Client(), false)
+	c.BaseURL, _ = url.Parse(server.URL)
+	return &DefaultCustomerService{Client: c}
+}
+
+func TestIdentificationWatcherPolls(t *testing.T) {
+	fake := &fakeService{identified: 3}
+	watcher := NewIdentificationWatcher(fake)
+
+	var waits []time.Duration
+	watcher.after = func(d time.Duration) <-chan time.Time {
+		waits = append(waits, d)
+		ch := make(chan time.Time, 1)
+		ch <- time.Now()
+		return ch
+	}
+
+	res, err := watcher.Wait(context.TODO(), "CUS_xnxdt6s1zg1f4nx")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Status != IdentificationSuccess || res.Identification.Value != "0123456789" {
+		t.Errorf("Expected successful identification, got %+v", res)
+	}
+
+	if len(waits) != 2 || waits[1] != 2*waits[0] {
+		t.Errorf("Expected two doubling waits, got %v", waits)
+	}
+}
+
+func TestIdentificationWatcherWebhook(t *testing.T) {
+	fake := &fakeService{}
+	watcher := NewIdentificationWatcher(fake)
+
+	// after is called once Wait has polled and is waiting for the outcome
+	waiting := make(chan struct{}, 1)
+	watcher.after = func(d time.Duration) <-chan time.Time {
+		waiting <- struct{}{}
+		return nil
+	}
+
+	done := make(chan *IdentificationResult)
+	go func() {
+		res, err := watcher.Wait(context.TODO(), "CUS_xnxdt6s1zg1f4nx")
+		if err != nil {
+			t.Error(err)
+		}
+		done <- res
+	}()
+
+	body := []byte(`{"event":"customeridentification.failed","data":{"customer_code":"CUS_xnxdt6s1zg1f4nx","email":"user@example.com","reason":"Account number or BVN is incorrect","identification":{"country":"NG","type":"bank_account","bvn":"123*****456","account_number":"012****345","bank_code":"999991"}}}`)
+
+	<-waiting
+	handled, err := watcher.HandleWebhook(body)
+	if err != nil || !handled {
+		t.Fatalf("Expected identification event to be handled, got %v %v", handled, err)
+	}
+
+	select {
+	case res := <-done:
+		if res.Status != IdentificationFailed || res.Reason == "" || res.Identification.BankCode != "999991" {
+			t.Errorf("Expected failed identification, got %+v", res)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected Wait to return after the webhook")
+	}
+
+	handled, _ = watcher.HandleWebhook([]byte(`{"event":"charge.success","data":{}}`))
+	if handled {
+		t.Error("Expected charge.success to be ignored")
+	}
+}
+
+func TestValidateCustomerAndListOptions(t *testing.T) {
+	var query url.Values
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		if r.Method == http.MethodPost {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"status":  true,
+				"message": "Customer Identification in progress",
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": true,
+			"data": []interface{}{
+				map[string]interface{}{
+					"id": 1, "email": "user@example.com", "customer_code": "CUS_xnxdt6s1zg1f4nx",
+					"authorizations": []interface{}{
+						map[string]interface{}{"authorization_code": "AUTH_8dfhjjdt", "last4": "4081", "reusable": true},
+					},
+				},
+			},
+			"meta": map[string]interface{}{"total": 1, "perPage": 50, "page": 1},
+		})
+	})
+
+	res, err := service.ValidateCustomer(context.TODO(), "CUS_xnxdt6s1zg1f4nx", &ValidateCustomerRequest{Country: "NG", Type: "bank_account"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Status != IdentificationPending || res.Message != "Customer Identification in progress" {
+		t.Errorf("Expected pending identification, got %+v", res)
+	}
+
+	list, err := service.ListWithOptions(context.TODO(), &ListOptions{Email: "user@example.com", From: "2023-01-01", PerPage: 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if query.Get("email") != "user@example.com" || query.Get("from") != "2023-01-01" || query.Has("to") {
+		t.Errorf("Expected email and from filters, got %v", query)
+	}
+
+	if len(list.Values) != 1 || list.Values[0].Authorizations[0].AuthorizationCode != "AUTH_8dfhjjdt" {
+		t.Errorf("Expected typed authorizations, got %+v", list.Values)
+	}
+}
+
+func TestIdentificationWatcherRetryAfterFailure(t *testing.T) {
+	fake := &fakeService{}
+	watcher := NewIdentificationWatcher(fake)
+	watcher.after = func(d time.Duration) <-chan time.Time {
+		ch := make(chan time.Time, 1)
+		ch <- time.Now()
+		return ch
+	}
+
+	failed := []byte(`{"event":"customeridentification.failed","data":{"customer_code":"CUS_retry","reason":"BVN mismatch"}}`)
+
+	// the failure arrives before anyone waits, and is kept for the next Wait
+	if _, err := watcher.HandleWebhook(failed); err != nil {
+		t.Fatal(err)
+	}
+	res, err := watcher.Wait(context.TODO(), "CUS_retry")
+	if err != nil || res.Status != IdentificationFailed {
+		t.Fatalf("Expected the kept failure, got %+v (%v)", res, err)
+	}
+	if _, ok := watcher.Result("CUS_retry"); ok {
+		t.Error("Expected Wait to consume the kept failure")
+	}
+
+	// a stale failure is discarded when the identification is retried
+	_, _ = watcher.HandleWebhook(failed)
+	if _, err := watcher.Validate(context.TODO(), "CUS_retry", &ValidateCustomerRequest{Country: "NG", Type: "bank_account"}); err != nil {
+		t.Fatal(err)
+	}
+	if fake.validations != 1 {
+		t.Errorf("Expected the identification to be retried, got %d validations", fake.validations)
+	}
+
+	fake.identified = fake.gets + 2
+	res, err = watcher.Wait(context.TODO(), "CUS_retry")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Status != IdentificationSuccess {
+		t.Errorf("Expected the retried identification to succeed, got %+v", res)
+	}
+}
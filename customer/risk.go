@@ -0,0 +1,188 @@
+package customer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// defaultRiskConcurrency is the number of risk actions SetBulk sends at once when Concurrency is not set
+const defaultRiskConcurrency = 5
+
+var (
+	// ErrInvalidRiskAction is returned when a risk action is not one of default, allow or deny
+	ErrInvalidRiskAction = errors.New("customer: invalid risk action")
+	// ErrMissingAuditInfo is returned when a risk change does not say who made it and why
+	ErrMissingAuditInfo = errors.New("customer: risk change requires an actor and a reason")
+	// ErrAuditFailed is returned when the audit hook could not record a risk change
+	ErrAuditFailed = errors.New("customer: risk change audit failed")
+)
+
+// AuditOutcome tells what an AuditEntry records
+type AuditOutcome string
+
+const (
+	// AuditAttempted is recorded before the change is sent to Paystack
+	AuditAttempted AuditOutcome = "attempted"
+	// AuditApplied is recorded once Paystack has applied the change
+	AuditApplied AuditOutcome = "applied"
+	// AuditFailed is recorded when the change could not be sent or Paystack refused it
+	AuditFailed AuditOutcome = "failed"
+	// AuditRejected is recorded when the change is invalid and was not sent
+	AuditRejected AuditOutcome = "rejected"
+)
+
+// RiskChange whitelists or blacklists a customer on behalf of Actor
+type RiskChange struct {
+	CustomerCode string
+	Action       RiskAction
+	// Actor identifies who requested the change, e.g. the fraud analyst's email
+	Actor  string
+	Reason string
+}
+
+// RiskResult is the outcome of one change sent by SetBulk
+type RiskResult struct {
+	Change   RiskChange
+	Customer *Customer
+	Err      error
+}
+
+// AuditEntry records a step of a risk change. Err is set for failed and rejected changes.
+type AuditEntry struct {
+	Outcome      AuditOutcome
+	CustomerCode string
+	Previous     RiskAction
+	Action       RiskAction
+	Actor        string
+	Reason       string
+	At           time.Time
+	Err          error
+}
+
+// RiskManager applies risk actions and records every change to an audit hook.
+// A change is recorded as attempted before it is sent, and is not sent if that record fails;
+// its outcome is recorded once Paystack answers. Invalid changes are recorded as rejected.
+// It is safe for concurrent use as long as Audit is.
+type RiskManager struct {
+	Service Service
+	// Audit stores an audit entry. Without it, changes are applied without being recorded.
+	Audit func(ctx context.Context, entry AuditEntry) error
+	// Concurrency caps the number of changes SetBulk sends at once
+	Concurrency int
+
+	now func() time.Time
+}
+
+// NewRiskManager creates a risk manager using the given customer service
+func NewRiskManager(service Service, audit func(ctx context.Context, entry AuditEntry) error) *RiskManager {
+	return &RiskManager{Service: service, Audit: audit, Concurrency: defaultRiskConcurrency, now: time.Now}
+}
+
+// Set applies a single risk change.
+// The customer's previous risk action is fetched first so the audit entries record the transition.
+// When the outcome cannot be recorded, the customer is returned together with an ErrAuditFailed error.
+func (m *RiskManager) Set(ctx context.Context, change RiskChange) (*Customer, error) {
+	entry := AuditEntry{
+		CustomerCode: change.CustomerCode,
+		Action:       change.Action,
+		Actor:        change.Actor,
+		Reason:       change.Reason,
+	}
+
+	var invalid error
+	switch {
+	case !change.Action.Valid():
+		invalid = fmt.Errorf("%w %q", ErrInvalidRiskAction, change.Action)
+	case change.Actor == "" || change.Reason == "":
+		invalid = fmt.Errorf("%w: %s", ErrMissingAuditInfo, change.CustomerCode)
+	}
+	if invalid != nil {
+		if err := m.audit(ctx, entry, AuditRejected, invalid); err != nil {
+			return nil, fmt.Errorf("%w (%v)", invalid, err)
+		}
+		return nil, invalid
+	}
+
+	previous, err := m.Service.Get(ctx, change.CustomerCode)
+	if err != nil {
+		if auditErr := m.audit(ctx, entry, AuditFailed, err); auditErr != nil {
+			return nil, fmt.Errorf("%w (%v)", err, auditErr)
+		}
+		return nil, err
+	}
+	entry.Previous = previous.RiskAction
+
+	// fail closed: a change that cannot be recorded is not made
+	if err := m.audit(ctx, entry, AuditAttempted, nil); err != nil {
+		return nil, err
+	}
+
+	cust, err := m.Service.SetRiskAction(ctx, change.CustomerCode, change.Action)
+	if err != nil {
+		if auditErr := m.audit(ctx, entry, AuditFailed, err); auditErr != nil {
+			return nil, fmt.Errorf("%w (%v)", err, auditErr)
+		}
+		return nil, err
+	}
+
+	if err := m.audit(ctx, entry, AuditApplied, nil); err != nil {
+		return cust, err
+	}
+	return cust, nil
+}
+
+// SetBulk applies the changes, at most Concurrency at a time, and returns one result per change in the same order.
+// A failed change does not stop the others.
+func (m *RiskManager) SetBulk(ctx context.Context, changes []RiskChange) []RiskResult {
+	concurrency := m.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultRiskConcurrency
+	}
+
+	results := make([]RiskResult, len(changes))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, change := range changes {
+		results[i].Change = change
+
+		select {
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int, change RiskChange) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i].Customer, results[i].Err = m.Set(ctx, change)
+		}(i, change)
+	}
+
+	wg.Wait()
+	return results
+}
+
+// audit records entry with the given outcome, wrapping a failure of the hook in ErrAuditFailed
+func (m *RiskManager) audit(ctx context.Context, entry AuditEntry, outcome AuditOutcome, err error) error {
+	if m.Audit == nil {
+		return nil
+	}
+
+	now := m.now
+	if now == nil {
+		now = time.Now
+	}
+	entry.Outcome = outcome
+	entry.At = now()
+	entry.Err = err
+	if err := m.Audit(ctx, entry); err != nil {
+		return fmt.Errorf("%w: %s %s: %v", ErrAuditFailed, entry.CustomerCode, outcome, err)
+	}
+	return nil
+}
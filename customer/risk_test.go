@@ -0,0 +1,159 @@
+package customer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeRiskService struct {
+	Service
+
+	mu      sync.Mutex
+	actions map[string]RiskAction
+	active  int
+	peak    int
+}
+
+func (f *fakeRiskService) Get(ctx context.Context, emailOrCode string) (*Customer, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if emailOrCode == "CUS_missing" {
+		return nil, errors.New("customer not found")
+	}
+	action, ok := f.actions[emailOrCode]
+	if !ok {
+		action = RiskActionDefault
+	}
+	return &Customer{CustomerCode: emailOrCode, RiskAction: action}, nil
+}
+
+func (f *fakeRiskService) SetRiskAction(ctx context.Context, customerCode string, riskAction RiskAction) (*Customer, error) {
+	f.mu.Lock()
+	f.active++
+	if f.active > f.peak {
+		f.peak = f.active
+	}
+	f.mu.Unlock()
+
+	time.Sleep(5 * time.Millisecond)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.active--
+	f.actions[customerCode] = riskAction
+	return &Customer{CustomerCode: customerCode, RiskAction: riskAction}, nil
+}
+
+func TestRiskManagerSetBulk(t *testing.T) {
+	fake := &fakeRiskService{actions: map[string]RiskAction{"CUS_1": RiskActionAllow}}
+
+	var mu sync.Mutex
+	var entries []AuditEntry
+	manager := NewRiskManager(fake, func(ctx context.Context, entry AuditEntry) error {
+		mu.Lock()
+		defer mu.Unlock()
+		entries = append(entries, entry)
+		return nil
+	})
+	manager.Concurrency = 2
+
+	codes := []string{"CUS_1", "CUS_2", "CUS_3", "CUS_4", "CUS_missing"}
+	changes := make([]RiskChange, len(codes))
+	for i, code := range codes {
+		changes[i] = RiskChange{CustomerCode: code, Action: RiskActionDeny, Actor: "analyst@example.com", Reason: "chargeback ring"}
+	}
+	changes = append(changes, RiskChange{CustomerCode: "CUS_5", Action: RiskActionDeny})
+
+	results := manager.SetBulk(context.TODO(), changes)
+	if len(results) != len(changes) {
+		t.Fatalf("Expected %d results, got %d", len(changes), len(results))
+	}
+
+	for i, res := range results[:4] {
+		if res.Err != nil || res.Customer.RiskAction != RiskActionDeny || res.Change.CustomerCode != codes[i] {
+			t.Errorf("Expected %s to be denied, got %+v", codes[i], res)
+		}
+	}
+
+	if results[4].Err == nil {
+		t.Error("Expected unknown customer to fail")
+	}
+
+	if !errors.Is(results[5].Err, ErrMissingAuditInfo) {
+		t.Errorf("Expected missing audit info error, got %v", results[5].Err)
+	}
+
+	if fake.peak > 2 {
+		t.Errorf("Expected at most 2 concurrent changes, got %d", fake.peak)
+	}
+
+	// applied changes are recorded before and after, failed and rejected ones once
+	outcomes := map[string][]AuditOutcome{}
+	for _, entry := range entries {
+		outcomes[entry.CustomerCode] = append(outcomes[entry.CustomerCode], entry.Outcome)
+
+		switch entry.CustomerCode {
+		case "CUS_1":
+			if entry.Previous != RiskActionAllow || entry.Actor != "analyst@example.com" || entry.At.IsZero() {
+				t.Errorf("Expected allow to deny transition by analyst, got %+v", entry)
+			}
+		case "CUS_missing", "CUS_5":
+			if entry.Err == nil {
+				t.Errorf("Expected the unapplied change to be audited with its error, got %+v", entry)
+			}
+		}
+	}
+
+	want := map[string][]AuditOutcome{
+		"CUS_1":       {AuditAttempted, AuditApplied},
+		"CUS_4":       {AuditAttempted, AuditApplied},
+		"CUS_missing": {AuditFailed},
+		"CUS_5":       {AuditRejected},
+	}
+	for code, w := range want {
+		got := outcomes[code]
+		if len(got) != len(w) || got[0] != w[0] || got[len(got)-1] != w[len(w)-1] {
+			t.Errorf("Expected %s to be audited as %v, got %v", code, w, got)
+		}
+	}
+	if len(entries) != 10 {
+		t.Errorf("Expected 10 audit entries, got %d", len(entries))
+	}
+}
+
+func TestRiskManagerFailsClosed(t *testing.T) {
+	fake := &fakeRiskService{actions: map[string]RiskAction{}}
+	failOn := AuditAttempted
+	manager := NewRiskManager(fake, func(ctx context.Context, entry AuditEntry) error {
+		if entry.Outcome == failOn {
+			return errors.New("audit store unavailable")
+		}
+		return nil
+	})
+	change := RiskChange{CustomerCode: "CUS_1", Action: RiskActionDeny, Actor: "analyst@example.com", Reason: "chargeback"}
+
+	if _, err := manager.Set(context.TODO(), change); !errors.Is(err, ErrAuditFailed) {
+		t.Errorf("Expected ErrAuditFailed, got %v", err)
+	}
+	if _, ok := fake.actions["CUS_1"]; ok {
+		t.Error("Expected the change not to be sent when it cannot be recorded")
+	}
+
+	// the outcome cannot be recorded, but the change was made and the attempt is on record
+	failOn = AuditApplied
+	cust, err := manager.Set(context.TODO(), change)
+	if !errors.Is(err, ErrAuditFailed) || cust == nil || cust.RiskAction != RiskActionDeny {
+		t.Errorf("Expected the applied change with ErrAuditFailed, got %+v and %v", cust, err)
+	}
+}
+
+func TestSetRiskActionRejectsUnknownAction(t *testing.T) {
+	service := &DefaultCustomerService{}
+	if _, err := service.SetRiskAction(context.TODO(), "CUS_1", "block"); !errors.Is(err, ErrInvalidRiskAction) {
+		t.Errorf("Expected invalid risk action error, got %v", err)
+	}
+}
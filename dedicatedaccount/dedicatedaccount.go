@@ -0,0 +1,169 @@
+package dedicatedaccount
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hub1989/paystack-api-wrapper/client"
+	"github.com/hub1989/paystack-api-wrapper/customer"
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+var (
+	// ErrNotFound is returned by GetForCustomer when the customer has no active dedicated account
+	ErrNotFound = errors.New("dedicatedaccount: no active dedicated account for customer")
+	// ErrNoCustomerID is returned by GetForCustomer for a customer without an ID
+	ErrNoCustomerID = errors.New("dedicatedaccount: customer id is required")
+)
+
+type Service interface {
+	Create(ctx context.Context, req *Request) (*DedicatedAccount, error)
+	Assign(ctx context.Context, req *AssignRequest) (response.Response, error)
+	List(ctx context.Context, opts *ListOptions) (*List, error)
+	Get(ctx context.Context, id int) (*DedicatedAccount, error)
+	GetForCustomer(ctx context.Context, cust *customer.Customer) (*DedicatedAccount, error)
+	Requery(ctx context.Context, accountNumber, providerSlug, date string) (response.Response, error)
+	Deactivate(ctx context.Context, id int) (*DedicatedAccount, error)
+	AddSplit(ctx context.Context, req *SplitRequest) (*DedicatedAccount, error)
+	RemoveSplit(ctx context.Context, accountNumber string) (*DedicatedAccount, error)
+	ListProviders(ctx context.Context) (*ProviderList, error)
+}
+
+// DefaultDedicatedAccountService handles operations related to dedicated virtual accounts
+// For more details see https://paystack.com/docs/api/dedicated-virtual-account/
+type DefaultDedicatedAccountService struct {
+	*client.Client
+}
+
+// Create creates a dedicated account for an existing customer
+// For more details see https://paystack.com/docs/api/dedicated-virtual-account/#create
+func (s *DefaultDedicatedAccountService) Create(ctx context.Context, req *Request) (*DedicatedAccount, error) {
+	account := &DedicatedAccount{}
+	err := s.Client.Call(ctx, http.MethodPost, "/dedicated_account", req, account)
+	return account, err
+}
+
+// Assign creates a customer, validates them and assigns a dedicated account.
+// The assignment completes asynchronously and is reported through the dedicatedaccount.assign webhooks.
+// For more details see https://paystack.com/docs/api/dedicated-virtual-account/#assign
+func (s *DefaultDedicatedAccountService) Assign(ctx context.Context, req *AssignRequest) (response.Response, error) {
+	resp := response.Response{}
+	err := s.Client.Call(ctx, http.MethodPost, "/dedicated_account/assign", req, &resp)
+	return resp, err
+}
+
+// List returns the dedicated accounts matching opts
+// For more details see https://paystack.com/docs/api/dedicated-virtual-account/#list
+func (s *DefaultDedicatedAccountService) List(ctx context.Context, opts *ListOptions) (*List, error) {
+	params := url.Values{}
+	if opts != nil {
+		if opts.Active != nil {
+			params.Set("active", strconv.FormatBool(*opts.Active))
+		}
+		params.Set("currency", opts.Currency)
+		params.Set("provider_slug", opts.ProviderSlug)
+		params.Set("bank_id", opts.BankID)
+		params.Set("customer", opts.Customer)
+		if opts.PerPage > 0 {
+			params.Set("perPage", strconv.Itoa(opts.PerPage))
+		}
+		if opts.Page > 0 {
+			params.Set("page", strconv.Itoa(opts.Page))
+		}
+	}
+
+	accounts := &List{}
+	err := s.Client.Call(ctx, http.MethodGet, client.AddQuery("/dedicated_account", params), nil, accounts)
+	return accounts, err
+}
+
+// Get returns the details of a dedicated account
+// For more details see https://paystack.com/docs/api/dedicated-virtual-account/#fetch
+func (s *DefaultDedicatedAccountService) Get(ctx context.Context, id int) (*DedicatedAccount, error) {
+	u := fmt.Sprintf("/dedicated_account/%d", id)
+	account := &DedicatedAccount{}
+	err := s.Client.Call(ctx, http.MethodGet, u, nil, account)
+	return account, err
+}
+
+// GetForCustomer returns the active dedicated account of a customer.
+// The account embedded in a customer returned by customer Get is used when it is active;
+// otherwise the customer's accounts are listed, which needs the customer ID.
+func (s *DefaultDedicatedAccountService) GetForCustomer(ctx context.Context, cust *customer.Customer) (*DedicatedAccount, error) {
+	if cust.DedicatedAccount != nil {
+		account := &DedicatedAccount{}
+		if err := client.Decode(cust.DedicatedAccount, account); err == nil && account.Active {
+			return account, nil
+		}
+	}
+
+	if cust.ID == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoCustomerID, cust.CustomerCode)
+	}
+
+	active := true
+	accounts, err := s.List(ctx, &ListOptions{Active: &active, Customer: strconv.Itoa(cust.ID)})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(accounts.Values) == 0 {
+		return nil, fmt.Errorf("%w %s", ErrNotFound, cust.CustomerCode)
+	}
+	return &accounts.Values[0], nil
+}
+
+// Requery checks a dedicated account for new transactions that have not been reported yet.
+// providerSlug and date (YYYY-MM-DD) are optional.
+// For more details see https://paystack.com/docs/api/dedicated-virtual-account/#requery
+func (s *DefaultDedicatedAccountService) Requery(ctx context.Context, accountNumber, providerSlug, date string) (response.Response, error) {
+	params := url.Values{}
+	params.Set("account_number", accountNumber)
+	params.Set("provider_slug", providerSlug)
+	params.Set("date", date)
+
+	resp := response.Response{}
+	err := s.Client.Call(ctx, http.MethodGet, client.AddQuery("/dedicated_account/requery", params), nil, &resp)
+	return resp, err
+}
+
+// Deactivate deactivates a dedicated account
+// For more details see https://paystack.com/docs/api/dedicated-virtual-account/#deactivate
+func (s *DefaultDedicatedAccountService) Deactivate(ctx context.Context, id int) (*DedicatedAccount, error) {
+	u := fmt.Sprintf("/dedicated_account/%d", id)
+	account := &DedicatedAccount{}
+	err := s.Client.Call(ctx, http.MethodDelete, u, nil, account)
+	return account, err
+}
+
+// AddSplit splits the payments received on a customer's dedicated account, creating the account if needed
+// For more details see https://paystack.com/docs/api/dedicated-virtual-account/#add-split
+func (s *DefaultDedicatedAccountService) AddSplit(ctx context.Context, req *SplitRequest) (*DedicatedAccount, error) {
+	account := &DedicatedAccount{}
+	err := s.Client.Call(ctx, http.MethodPost, "/dedicated_account/split", req, account)
+	return account, err
+}
+
+// RemoveSplit removes the split from a dedicated account
+// For more details see https://paystack.com/docs/api/dedicated-virtual-account/#remove-split
+func (s *DefaultDedicatedAccountService) RemoveSplit(ctx context.Context, accountNumber string) (*DedicatedAccount, error) {
+	reqBody := struct {
+		AccountNumber string `json:"account_number"`
+	}{
+		AccountNumber: accountNumber,
+	}
+	account := &DedicatedAccount{}
+	err := s.Client.Call(ctx, http.MethodDelete, "/dedicated_account/split", reqBody, account)
+	return account, err
+}
+
+// ListProviders returns the banks that can issue dedicated accounts
+// For more details see https://paystack.com/docs/api/dedicated-virtual-account/#providers
+func (s *DefaultDedicatedAccountService) ListProviders(ctx context.Context) (*ProviderList, error) {
+	providers := &ProviderList{}
+	err := s.Client.Call(ctx, http.MethodGet, "/dedicated_account/available_providers", nil, providers)
+	return providers, err
+}
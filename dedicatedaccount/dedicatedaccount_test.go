@@ -0,0 +1,77 @@
+package dedicatedaccount
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/hub1989/paystack-api-wrapper/client"
+	"github.com/hub1989/paystack-api-wrapper/configuration"
+	"github.com/hub1989/paystack-api-wrapper/customer"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+var c *client.Client
+var service *DefaultDedicatedAccountService
+
+func init() {
+	apiKey := client.MustGetTestKey()
+	c = configuration.NewClient(apiKey, nil, true)
+	service = &DefaultDedicatedAccountService{Client: c}
+}
+
+func TestListProviders(t *testing.T) {
+	providers, err := service.ListProviders(context.TODO())
+	if err != nil || !(len(providers.Values) > 0) {
+		t.Errorf("Expected Provider list, got %d, returned error %v", len(providers.Values), err)
+	}
+}
+
+func TestDedicatedAccountList(t *testing.T) {
+	active := true
+	accounts, err := service.List(context.TODO(), &ListOptions{Active: &active, Currency: "NGN"})
+	if err != nil {
+		t.Errorf("Expected Dedicated account list, got %d, returned error %v", len(accounts.Values), err)
+	}
+}
+
+func TestGetForCustomer(t *testing.T) {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": true,
+			"data":   []interface{}{map[string]interface{}{"id": 7, "account_number": "9930000737", "active": true}},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	offline := configuration.NewClient("sk_test", server.Client(), false)
+	offline.BaseURL, _ = url.Parse(server.URL)
+	accounts := &DefaultDedicatedAccountService{Client: offline}
+
+	if _, err := accounts.GetForCustomer(context.TODO(), &customer.Customer{CustomerCode: "CUS_new"}); !errors.Is(err, ErrNoCustomerID) {
+		t.Errorf("Expected ErrNoCustomerID, got %v", err)
+	}
+
+	// the account embedded in a fetched customer is used as it is
+	fetched := &customer.Customer{ID: 12, DedicatedAccount: map[string]interface{}{"id": 3, "account_number": "9930000111", "active": true}}
+	account, err := accounts.GetForCustomer(context.TODO(), fetched)
+	if err != nil || account.ID != 3 {
+		t.Errorf("Expected the embedded account, got %+v, %v", account, err)
+	}
+
+	if len(queries) != 0 {
+		t.Fatalf("Expected no request, got %v", queries)
+	}
+
+	account, err = accounts.GetForCustomer(context.TODO(), &customer.Customer{ID: 12})
+	if err != nil || account.ID != 7 {
+		t.Errorf("Expected the listed account, got %+v, %v", account, err)
+	}
+	if len(queries) != 1 || queries[0].Get("customer") != "12" || queries[0].Get("active") != "true" {
+		t.Errorf("Expected the active accounts of customer 12 to be listed, got %v", queries)
+	}
+}
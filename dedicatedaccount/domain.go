@@ -0,0 +1,112 @@
+package dedicatedaccount
+
+import (
+	"github.com/hub1989/paystack-api-wrapper/client"
+	"github.com/hub1989/paystack-api-wrapper/customer"
+	"github.com/hub1989/paystack-api-wrapper/response"
+)
+
+// DedicatedAccount is the resource representing a dedicated virtual account (NUBAN) issued to a customer.
+// For more details see https://paystack.com/docs/api/dedicated-virtual-account/
+type DedicatedAccount struct {
+	ID            int               `json:"id,omitempty"`
+	CreatedAt     string            `json:"createdAt,omitempty"`
+	UpdatedAt     string            `json:"updatedAt,omitempty"`
+	AccountName   string            `json:"account_name,omitempty"`
+	AccountNumber string            `json:"account_number,omitempty"`
+	Assigned      bool              `json:"assigned,omitempty"`
+	Currency      string            `json:"currency,omitempty"`
+	Metadata      client.Metadata   `json:"metadata,omitempty"`
+	Active        bool              `json:"active,omitempty"`
+	SplitConfig   interface{}       `json:"split_config,omitempty"`
+	Bank          Bank              `json:"bank,omitempty"`
+	Assignment    Assignment        `json:"assignment,omitempty"`
+	Customer      customer.Customer `json:"customer,omitempty"`
+}
+
+// Bank is the bank a dedicated account is held with
+type Bank struct {
+	ID   int    `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	Slug string `json:"slug,omitempty"`
+}
+
+// Assignment describes who a dedicated account is assigned to
+type Assignment struct {
+	Integration  int    `json:"integration,omitempty"`
+	AssigneeID   int    `json:"assignee_id,omitempty"`
+	AssigneeType string `json:"assignee_type,omitempty"`
+	Expired      bool   `json:"expired,omitempty"`
+	AccountType  string `json:"account_type,omitempty"`
+	AssignedAt   string `json:"assigned_at,omitempty"`
+}
+
+// Provider is a bank that can issue dedicated accounts
+type Provider struct {
+	ID           int    `json:"id,omitempty"`
+	ProviderSlug string `json:"provider_slug,omitempty"`
+	BankID       int    `json:"bank_id,omitempty"`
+	BankName     string `json:"bank_name,omitempty"`
+}
+
+// Request represents a request to create a dedicated account for an existing customer
+type Request struct {
+	// customer ID or code
+	Customer string `json:"customer,omitempty"`
+	// provider slug, e.g. wema-bank or titan-paystack
+	PreferredBank string `json:"preferred_bank,omitempty"`
+	Subaccount    string `json:"subaccount,omitempty"`
+	SplitCode     string `json:"split_code,omitempty"`
+	FirstName     string `json:"first_name,omitempty"`
+	LastName      string `json:"last_name,omitempty"`
+	Phone         string `json:"phone,omitempty"`
+}
+
+// AssignRequest represents a request to create a customer, validate them and assign a dedicated account in one step
+type AssignRequest struct {
+	Email         string `json:"email,omitempty"`
+	FirstName     string `json:"first_name,omitempty"`
+	MiddleName    string `json:"middle_name,omitempty"`
+	LastName      string `json:"last_name,omitempty"`
+	Phone         string `json:"phone,omitempty"`
+	PreferredBank string `json:"preferred_bank,omitempty"`
+	Country       string `json:"country,omitempty"`
+	AccountNumber string `json:"account_number,omitempty"`
+	BVN           string `json:"bvn,omitempty"`
+	BankCode      string `json:"bank_code,omitempty"`
+	Subaccount    string `json:"subaccount,omitempty"`
+	SplitCode     string `json:"split_code,omitempty"`
+}
+
+// SplitRequest represents a request to split the payments received on a customer's dedicated account
+type SplitRequest struct {
+	// customer ID or code
+	Customer      string `json:"customer,omitempty"`
+	Subaccount    string `json:"subaccount,omitempty"`
+	SplitCode     string `json:"split_code,omitempty"`
+	PreferredBank string `json:"preferred_bank,omitempty"`
+}
+
+// ListOptions filters the dedicated accounts returned by List
+type ListOptions struct {
+	// Active filters by status when set
+	Active       *bool
+	Currency     string
+	ProviderSlug string
+	BankID       string
+	// Customer is the customer ID
+	Customer string
+	PerPage  int
+	Page     int
+}
+
+// List is a list object for dedicated accounts.
+type List struct {
+	Meta   response.ListMeta
+	Values []DedicatedAccount `json:"data"`
+}
+
+// ProviderList is a list object for dedicated account providers.
+type ProviderList struct {
+	Values []Provider `json:"data"`
+}
@@ -0,0 +1,169 @@
+package dispute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hub1989/paystack-api-wrapper/client"
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strconv"
+)
+
+// ErrUploadFailed is returned by UploadEvidence when the signed URL rejects the file
+var ErrUploadFailed = errors.New("dispute: evidence upload failed")
+
+type Service interface {
+	List(ctx context.Context, opts *ListOptions) (*List, error)
+	Get(ctx context.Context, id int) (*Dispute, error)
+	ListForTransaction(ctx context.Context, transactionID int) ([]Dispute, error)
+	Update(ctx context.Context, id int, req *UpdateRequest) (*Dispute, error)
+	AddEvidence(ctx context.Context, id int, evidence *Evidence) (*Evidence, error)
+	GetUploadURL(ctx context.Context, id int, filename string) (*UploadURL, error)
+	UploadEvidence(ctx context.Context, id int, filename string, r io.Reader) (string, error)
+	Resolve(ctx context.Context, id int, req *ResolveRequest) (*Dispute, error)
+	Export(ctx context.Context, opts *ListOptions) (*Export, error)
+}
+
+// DefaultDisputeService handles operations related to disputes
+// For more details see https://paystack.com/docs/api/dispute/
+type DefaultDisputeService struct {
+	*client.Client
+}
+
+// List returns the disputes matching opts
+// For more details see https://paystack.com/docs/api/dispute/#list
+func (s *DefaultDisputeService) List(ctx context.Context, opts *ListOptions) (*List, error) {
+	disputes := &List{}
+	err := s.Client.Call(ctx, http.MethodGet, client.AddQuery("/dispute", listParams(opts)), nil, disputes)
+	return disputes, err
+}
+
+// Get returns the details of a dispute
+// For more details see https://paystack.com/docs/api/dispute/#fetch
+func (s *DefaultDisputeService) Get(ctx context.Context, id int) (*Dispute, error) {
+	u := fmt.Sprintf("/dispute/%d", id)
+	dispute := &Dispute{}
+	err := s.Client.Call(ctx, http.MethodGet, u, nil, dispute)
+	return dispute, err
+}
+
+// ListForTransaction returns the disputes raised on a transaction
+// For more details see https://paystack.com/docs/api/dispute/#transaction
+func (s *DefaultDisputeService) ListForTransaction(ctx context.Context, transactionID int) ([]Dispute, error) {
+	u := fmt.Sprintf("/dispute/transaction/%d", transactionID)
+	resp := response.Response{}
+	if err := s.Client.Call(ctx, http.MethodGet, u, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	// the endpoint returns a single dispute object, or a list when the transaction has several
+	if _, ok := resp["data"]; ok {
+		disputes := &List{}
+		err := client.Decode(resp, disputes)
+		return disputes.Values, err
+	}
+
+	dispute := Dispute{}
+	if err := client.Decode(resp, &dispute); err != nil {
+		return nil, err
+	}
+	return []Dispute{dispute}, nil
+}
+
+// Update updates the refund amount of a dispute, or attaches an uploaded file to it
+// For more details see https://paystack.com/docs/api/dispute/#update
+func (s *DefaultDisputeService) Update(ctx context.Context, id int, req *UpdateRequest) (*Dispute, error) {
+	u := fmt.Sprintf("/dispute/%d", id)
+	dispute := &Dispute{}
+	err := s.Client.Call(ctx, http.MethodPut, u, req, dispute)
+	return dispute, err
+}
+
+// AddEvidence provides proof of service for a dispute
+// For more details see https://paystack.com/docs/api/dispute/#evidence
+func (s *DefaultDisputeService) AddEvidence(ctx context.Context, id int, evidence *Evidence) (*Evidence, error) {
+	u := fmt.Sprintf("/dispute/%d/evidence", id)
+	ev := &Evidence{}
+	err := s.Client.Call(ctx, http.MethodPost, u, evidence, ev)
+	return ev, err
+}
+
+// GetUploadURL returns a signed URL the evidence file named filename can be uploaded to
+// For more details see https://paystack.com/docs/api/dispute/#upload-url
+func (s *DefaultDisputeService) GetUploadURL(ctx context.Context, id int, filename string) (*UploadURL, error) {
+	params := url.Values{}
+	params.Set("upload_filename", filename)
+	u := client.AddQuery(fmt.Sprintf("/dispute/%d/upload_url", id), params)
+
+	upload := &UploadURL{}
+	err := s.Client.Call(ctx, http.MethodGet, u, nil, upload)
+	return upload, err
+}
+
+// UploadEvidence uploads an evidence file for a dispute and returns the file name
+// to pass as UploadedFilename to Update or Resolve.
+// The content type is guessed from the extension of filename.
+func (s *DefaultDisputeService) UploadEvidence(ctx context.Context, id int, filename string, r io.Reader) (string, error) {
+	upload, err := s.GetUploadURL(ctx, id, filename)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, upload.SignedURL, r)
+	if err != nil {
+		return "", err
+	}
+	if contentType := mime.TypeByExtension(filepath.Ext(filename)); contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := s.Client.Client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return "", fmt.Errorf("%w: %s", ErrUploadFailed, resp.Status)
+	}
+	return upload.FileName, nil
+}
+
+// Resolve resolves a dispute
+// For more details see https://paystack.com/docs/api/dispute/#resolve
+func (s *DefaultDisputeService) Resolve(ctx context.Context, id int, req *ResolveRequest) (*Dispute, error) {
+	u := fmt.Sprintf("/dispute/%d/resolve", id)
+	dispute := &Dispute{}
+	err := s.Client.Call(ctx, http.MethodPut, u, req, dispute)
+	return dispute, err
+}
+
+// Export returns a link to a CSV export of the disputes matching opts
+// For more details see https://paystack.com/docs/api/dispute/#export
+func (s *DefaultDisputeService) Export(ctx context.Context, opts *ListOptions) (*Export, error) {
+	export := &Export{}
+	err := s.Client.Call(ctx, http.MethodGet, client.AddQuery("/dispute/export", listParams(opts)), nil, export)
+	return export, err
+}
+
+func listParams(opts *ListOptions) url.Values {
+	params := url.Values{}
+	if opts != nil {
+		params.Set("from", opts.From)
+		params.Set("to", opts.To)
+		params.Set("transaction", opts.Transaction)
+		params.Set("status", string(opts.Status))
+		if opts.PerPage > 0 {
+			params.Set("perPage", strconv.Itoa(opts.PerPage))
+		}
+		if opts.Page > 0 {
+			params.Set("page", strconv.Itoa(opts.Page))
+		}
+	}
+	return params
+}
@@ -0,0 +1,115 @@
+package dispute
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/hub1989/paystack-api-wrapper/configuration"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func newService(t *testing.T, handler http.HandlerFunc) (*DefaultDisputeService, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c := configuration.NewClient("sk_test", server.Client(), false)
+	c.BaseURL, _ = url.Parse(server.URL)
+	return &DefaultDisputeService{Client: c}, server
+}
+
+func TestUploadEvidence(t *testing.T) {
+	var uploaded, contentType, auth string
+	var serverURL string
+	service, server := newService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/dispute/42/upload_url":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": true,
+				"data": map[string]interface{}{
+					"signedUrl": serverURL + "/signed/receipt.pdf",
+					"fileName":  r.URL.Query().Get("upload_filename"),
+				},
+			})
+		case "/signed/receipt.pdf":
+			b, _ := io.ReadAll(r.Body)
+			uploaded, contentType, auth = string(b), r.Header.Get("Content-Type"), r.Header.Get("Authorization")
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	})
+	serverURL = server.URL
+
+	name, err := service.UploadEvidence(context.TODO(), 42, "receipt.pdf", strings.NewReader("%PDF-1.4"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if name != "receipt.pdf" || uploaded != "%PDF-1.4" {
+		t.Errorf("Expected receipt.pdf to be uploaded, got %q with %q", name, uploaded)
+	}
+
+	if contentType != "application/pdf" || auth != "" {
+		t.Errorf("Expected a pdf upload without the secret key, got content type %q and authorization %q", contentType, auth)
+	}
+
+	if _, err := service.UploadEvidence(context.TODO(), 7, "receipt.pdf", strings.NewReader("")); err == nil {
+		t.Error("Expected an error for a missing upload url")
+	}
+}
+
+func TestUploadEvidenceRejected(t *testing.T) {
+	var serverURL string
+	service, server := newService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/dispute/42/upload_url" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"status": true,
+				"data":   map[string]interface{}{"signedUrl": serverURL + "/signed", "fileName": "a.png"},
+			})
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+	})
+	serverURL = server.URL
+
+	if _, err := service.UploadEvidence(context.TODO(), 42, "a.png", strings.NewReader("png")); !errors.Is(err, ErrUploadFailed) {
+		t.Errorf("Expected ErrUploadFailed, got %v", err)
+	}
+}
+
+func TestListForTransaction(t *testing.T) {
+	service, _ := newService(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": true,
+			"data": map[string]interface{}{
+				"id":          2867,
+				"status":      "awaiting-merchant-feedback",
+				"transaction": map[string]interface{}{"id": 5991760, "reference": "asqcsqnppf"},
+				"customer":    map[string]interface{}{"id": 10, "email": "customer@example.com"},
+				"history":     []interface{}{map[string]interface{}{"status": "pending", "by": "demo@test.co"}},
+			},
+		})
+	})
+
+	disputes, err := service.ListForTransaction(context.TODO(), 5991760)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(disputes) != 1 {
+		t.Fatalf("Expected 1 dispute, got %d", len(disputes))
+	}
+
+	d := disputes[0]
+	if d.Status != StatusAwaitingMerchantFeedback || d.Transaction.Reference != "asqcsqnppf" || d.Customer.Email != "customer@example.com" {
+		t.Errorf("Unexpected dispute %+v", d)
+	}
+
+	if len(d.History) != 1 || d.History[0].Status != StatusPending {
+		t.Errorf("Expected dispute history, got %+v", d.History)
+	}
+}
@@ -0,0 +1,130 @@
+package dispute
+
+import (
+	"github.com/hub1989/paystack-api-wrapper/customer"
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"github.com/hub1989/paystack-api-wrapper/transaction"
+)
+
+// Status is the state of a dispute
+type Status string
+
+const (
+	StatusAwaitingMerchantFeedback Status = "awaiting-merchant-feedback"
+	StatusAwaitingBankFeedback     Status = "awaiting-bank-feedback"
+	StatusPending                  Status = "pending"
+	StatusResolved                 Status = "resolved"
+	StatusArchived                 Status = "archived"
+)
+
+// Resolution is the merchant's decision on a dispute
+type Resolution string
+
+const (
+	ResolutionMerchantAccepted Resolution = "merchant-accepted"
+	ResolutionDeclined         Resolution = "declined"
+)
+
+// Dispute is the resource representing a Paystack dispute (chargeback)
+// For more details see https://paystack.com/docs/api/dispute/
+type Dispute struct {
+	ID                   int                     `json:"id,omitempty"`
+	RefundAmount         float32                 `json:"refund_amount,omitempty"`
+	Currency             string                  `json:"currency,omitempty"`
+	Status               Status                  `json:"status,omitempty"`
+	Resolution           Resolution              `json:"resolution,omitempty"`
+	Domain               string                  `json:"domain,omitempty"`
+	Transaction          transaction.Transaction `json:"transaction,omitempty"`
+	TransactionReference string                  `json:"transaction_reference,omitempty"`
+	Category             string                  `json:"category,omitempty"`
+	Customer             customer.Customer       `json:"customer,omitempty"`
+	BIN                  string                  `json:"bin,omitempty"`
+	Last4                string                  `json:"last4,omitempty"`
+	DueAt                string                  `json:"dueAt,omitempty"`
+	ResolvedAt           string                  `json:"resolvedAt,omitempty"`
+	Evidence             *Evidence               `json:"evidence,omitempty"`
+	Attachments          string                  `json:"attachments,omitempty"`
+	Note                 string                  `json:"note,omitempty"`
+	History              []History               `json:"history,omitempty"`
+	Messages             []Message               `json:"messages,omitempty"`
+	CreatedAt            string                  `json:"createdAt,omitempty"`
+	UpdatedAt            string                  `json:"updatedAt,omitempty"`
+}
+
+// History is a status change in the life of a dispute
+type History struct {
+	Status    Status `json:"status,omitempty"`
+	By        string `json:"by,omitempty"`
+	CreatedAt string `json:"createdAt,omitempty"`
+}
+
+// Message is a message exchanged on a dispute
+type Message struct {
+	Sender    string `json:"sender,omitempty"`
+	Body      string `json:"body,omitempty"`
+	CreatedAt string `json:"createdAt,omitempty"`
+}
+
+// Evidence is the proof of service provided for a dispute.
+// DeliveryAddress and DeliveryDate (YYYY-MM-DD) are optional.
+type Evidence struct {
+	ID              int    `json:"id,omitempty"`
+	Dispute         int    `json:"dispute,omitempty"`
+	CustomerEmail   string `json:"customer_email,omitempty"`
+	CustomerName    string `json:"customer_name,omitempty"`
+	CustomerPhone   string `json:"customer_phone,omitempty"`
+	ServiceDetails  string `json:"service_details,omitempty"`
+	DeliveryAddress string `json:"delivery_address,omitempty"`
+	DeliveryDate    string `json:"delivery_date,omitempty"`
+	CreatedAt       string `json:"createdAt,omitempty"`
+	UpdatedAt       string `json:"updatedAt,omitempty"`
+}
+
+// UpdateRequest represents a request to update a dispute
+type UpdateRequest struct {
+	RefundAmount float32 `json:"refund_amount"`
+	// UploadedFilename is the file name returned by GetUploadURL or UploadEvidence
+	UploadedFilename string `json:"uploaded_filename,omitempty"`
+}
+
+// ResolveRequest represents a request to resolve a dispute
+type ResolveRequest struct {
+	Resolution   Resolution `json:"resolution"`
+	Message      string     `json:"message"`
+	RefundAmount float32    `json:"refund_amount"`
+	// UploadedFilename is the file name returned by GetUploadURL or UploadEvidence
+	UploadedFilename string `json:"uploaded_filename"`
+	// Evidence is the ID of the evidence added with AddEvidence. It is required when declining a dispute.
+	Evidence int `json:"evidence,omitempty"`
+}
+
+// UploadURL is a signed URL evidence files can be uploaded to
+type UploadURL struct {
+	SignedURL string `json:"signedUrl,omitempty"`
+	FileName  string `json:"fileName,omitempty"`
+	ExpiresIn int    `json:"expiresIn,omitempty"`
+}
+
+// Export is a link to a CSV export of disputes
+type Export struct {
+	Path      string `json:"path,omitempty"`
+	ExpiresAt string `json:"expiresAt,omitempty"`
+}
+
+// ListOptions filters the disputes returned by List and Export
+type ListOptions struct {
+	// From and To limit the creation date range, e.g. 2016-09-21T00:00:00.000Z
+	From string
+	To   string
+	// Transaction is the ID of the disputed transaction
+	Transaction string
+	Status      Status
+	PerPage     int
+	Page        int
+}
+
+// List is a list object for disputes.
+type List struct {
+	Meta   response.ListMeta
+	Values []Dispute `json:"data"`
+}
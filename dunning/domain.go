@@ -0,0 +1,104 @@
+package dunning
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// State is the dunning state of a subscription
+type State string
+
+const (
+	// StateDunning subscriptions have a failed renewal and are going through the schedule
+	StateDunning State = "dunning"
+	// StateRecovered subscriptions were paid after a failed renewal
+	StateRecovered State = "recovered"
+	// StateDisabled subscriptions reached the end of the schedule, or were disabled on Paystack
+	StateDisabled State = "disabled"
+)
+
+// Action is a step of the dunning schedule
+type Action string
+
+const (
+	ActionRetryCharge    Action = "retry_charge"
+	ActionSendUpdateLink Action = "send_update_link"
+	ActionDisable        Action = "disable"
+)
+
+// Step runs Action once After has passed since the renewal failed
+type Step struct {
+	After  time.Duration
+	Action Action
+}
+
+// DefaultSchedule retries the charge after a day, sends the manage link after three days,
+// retries again after five days and disables the subscription after a week.
+var DefaultSchedule = []Step{
+	{After: 24 * time.Hour, Action: ActionRetryCharge},
+	{After: 3 * 24 * time.Hour, Action: ActionSendUpdateLink},
+	{After: 5 * 24 * time.Hour, Action: ActionRetryCharge},
+	{After: 7 * 24 * time.Hour, Action: ActionDisable},
+}
+
+// Record is the dunning state of a subscription, as kept in a Store
+type Record struct {
+	SubscriptionCode string
+	State            State
+	// FailedAt is when the renewal failed. The schedule is relative to it.
+	FailedAt time.Time
+	// Step is the index of the next step of the schedule to run
+	Step int
+	// Retries counts the charge retries made since the renewal failed
+	Retries   int
+	LastError string
+	UpdatedAt time.Time
+}
+
+// NotificationKind tells what a Notification is about
+type NotificationKind string
+
+const (
+	NotifyPaymentFailed NotificationKind = "payment_failed"
+	NotifyRetryFailed   NotificationKind = "retry_failed"
+	NotifyUpdateLink    NotificationKind = "update_link"
+	NotifyDisabled      NotificationKind = "disabled"
+	NotifyRecovered     NotificationKind = "recovered"
+)
+
+// Notification is passed to Manager.Notify so the customer can be told about their subscription
+type Notification struct {
+	Kind   NotificationKind
+	Record Record
+	// Link is the manage link, for NotifyUpdateLink
+	Link string
+	// Err is the reason a retry failed, for NotifyRetryFailed
+	Err error
+}
+
+// Event is a Paystack webhook event
+// For more details see https://paystack.com/docs/payments/webhooks/
+type Event struct {
+	Event string                 `json:"event"`
+	Data  map[string]interface{} `json:"data"`
+}
+
+// ParseEvent decodes the body of a webhook request.
+// Verify the x-paystack-signature header before trusting the event.
+func ParseEvent(body []byte) (*Event, error) {
+	e := &Event{}
+	err := json.Unmarshal(body, e)
+	return e, err
+}
+
+// SubscriptionCode returns the code of the subscription the event is about, if any
+func (e *Event) SubscriptionCode() string {
+	if code, ok := e.Data["subscription_code"].(string); ok {
+		return code
+	}
+	if sub, ok := e.Data["subscription"].(map[string]interface{}); ok {
+		code, _ := sub["subscription_code"].(string)
+		return code
+	}
+	return ""
+}
@@ -0,0 +1,316 @@
+package dunning
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"github.com/hub1989/paystack-api-wrapper/subscription"
+	"github.com/hub1989/paystack-api-wrapper/transaction"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Webhook events handled by Manager.HandleEvent
+const (
+	EventInvoicePaymentFailed = "invoice.payment_failed"
+	EventInvoiceUpdate        = "invoice.update"
+	EventSubscriptionDisable  = "subscription.disable"
+)
+
+// ErrNoAuthorization is reported when a subscription has no authorization to retry the charge with
+var ErrNoAuthorization = errors.New("dunning: subscription has no reusable authorization")
+
+// Clock tells the time. Tests can replace it to move through the schedule.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Manager follows subscriptions whose renewal failed and runs the dunning schedule on them:
+// retrying the charge, sending the customer the manage link and finally disabling the subscription.
+// Failures are learnt from webhook events through HandleEvent; Run moves records through the schedule
+// and should be called periodically.
+// Work on a subscription is serialised, so an event is never overwritten by a step running at the same time.
+// Run a single Manager over a Store.
+type Manager struct {
+	Subscriptions subscription.Service
+	Transactions  transaction.Service
+	Store         Store
+	Schedule      []Step
+	Clock         Clock
+	// Notify, when set, is called for everything the customer may need to hear about
+	Notify func(ctx context.Context, n Notification)
+
+	// locks holds a *sync.Mutex per subscription code
+	locks sync.Map
+}
+
+// NewManager creates a Manager running DefaultSchedule
+func NewManager(subscriptions subscription.Service, transactions transaction.Service, store Store) *Manager {
+	return &Manager{
+		Subscriptions: subscriptions,
+		Transactions:  transactions,
+		Store:         store,
+		Schedule:      DefaultSchedule,
+		Clock:         systemClock{},
+	}
+}
+
+// HandleEvent updates the dunning state from a webhook event. Events not about subscription payments are ignored.
+func (m *Manager) HandleEvent(ctx context.Context, e *Event) error {
+	code := e.SubscriptionCode()
+	if code == "" {
+		return nil
+	}
+	defer m.lock(code)()
+
+	switch e.Event {
+	case EventInvoicePaymentFailed:
+		return m.fail(ctx, code)
+	case EventInvoiceUpdate:
+		if paid, _ := e.Data["paid"].(bool); paid {
+			return m.recover(ctx, code)
+		}
+	case EventSubscriptionDisable:
+		r, err := m.Store.Get(ctx, code)
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		r.State = StateDisabled
+		return m.save(ctx, r)
+	}
+	return nil
+}
+
+// Fail starts dunning a subscription whose renewal failed. Subscriptions already being dunned are left as they are.
+func (m *Manager) Fail(ctx context.Context, subscriptionCode string) error {
+	defer m.lock(subscriptionCode)()
+	return m.fail(ctx, subscriptionCode)
+}
+
+func (m *Manager) fail(ctx context.Context, subscriptionCode string) error {
+	r, err := m.Store.Get(ctx, subscriptionCode)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if err == nil && r.State == StateDunning {
+		return nil
+	}
+
+	r = &Record{SubscriptionCode: subscriptionCode, State: StateDunning, FailedAt: m.Clock.Now()}
+	if err := m.save(ctx, r); err != nil {
+		return err
+	}
+	m.notify(ctx, Notification{Kind: NotifyPaymentFailed, Record: *r})
+	return nil
+}
+
+// Run runs the next step of the schedule on every subscription being dunned whose step is due.
+// At most one step is run per subscription, so steps missed while Run was not called are caught up one call at a time.
+// It carries on past failures and returns the first error; failed steps are run again on the next call.
+func (m *Manager) Run(ctx context.Context) error {
+	records, err := m.Store.List(ctx, StateDunning)
+	if err != nil {
+		return err
+	}
+
+	var first error
+	for _, r := range records {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := m.advance(ctx, r.SubscriptionCode); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+func (m *Manager) advance(ctx context.Context, code string) error {
+	defer m.lock(code)()
+
+	// the listed record may be stale: an event may have come in since
+	r, err := m.Store.Get(ctx, code)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if r.State != StateDunning || r.Step >= len(m.Schedule) || m.Clock.Now().Sub(r.FailedAt) < m.Schedule[r.Step].After {
+		return nil
+	}
+
+	if err := m.run(ctx, r, m.Schedule[r.Step].Action); err != nil {
+		r.LastError = err.Error()
+		_ = m.save(ctx, r)
+		return fmt.Errorf("dunning %s: %w", r.SubscriptionCode, err)
+	}
+	r.Step++
+	r.LastError = ""
+	return m.save(ctx, r)
+}
+
+func (m *Manager) run(ctx context.Context, r *Record, action Action) error {
+	switch action {
+	case ActionRetryCharge:
+		return m.retry(ctx, r)
+	case ActionSendUpdateLink:
+		link, err := m.Subscriptions.GenerateUpdateLink(ctx, r.SubscriptionCode)
+		if err != nil {
+			return err
+		}
+		if _, err := m.Subscriptions.SendUpdateLink(ctx, r.SubscriptionCode); err != nil {
+			return err
+		}
+		m.notify(ctx, Notification{Kind: NotifyUpdateLink, Record: *r, Link: link})
+	case ActionDisable:
+		sub, err := m.Subscriptions.Get(ctx, r.SubscriptionCode)
+		if err != nil {
+			return err
+		}
+		if _, err := m.Subscriptions.Disable(ctx, r.SubscriptionCode, sub.EmailToken); err != nil {
+			return err
+		}
+		r.State = StateDisabled
+		m.notify(ctx, Notification{Kind: NotifyDisabled, Record: *r})
+	default:
+		return fmt.Errorf("unknown action %q", action)
+	}
+	return nil
+}
+
+// retry charges the subscription amount to its authorization. A declined charge is not an error:
+// the schedule moves on and the customer is notified.
+// Each step charges with its own RetryReference, so a charge whose outcome is unknown, e.g. after a
+// timeout, is verified rather than charged again when the step is run next.
+func (m *Manager) retry(ctx context.Context, r *Record) error {
+	reference := RetryReference(r)
+
+	if r.LastError != "" {
+		// the last attempt of this step may have charged the customer
+		trx, err := m.Transactions.Verify(ctx, reference)
+		var apiErr *response.APIError
+		switch {
+		case err == nil:
+			return m.settleRetry(ctx, r, trx)
+		case !errors.As(err, &apiErr) || apiErr.HTTPStatusCode >= 500:
+			return err
+		}
+		// Paystack does not know the reference, so the charge was never made
+	}
+
+	sub, err := m.Subscriptions.Get(ctx, r.SubscriptionCode)
+	if err != nil {
+		return err
+	}
+
+	email := field(sub.Customer, "email")
+	authorization := field(sub.Authorization, "authorization_code")
+	if email == "" || authorization == "" {
+		r.Retries++
+		m.notify(ctx, Notification{Kind: NotifyRetryFailed, Record: *r, Err: ErrNoAuthorization})
+		return nil
+	}
+
+	trx, err := m.Transactions.ChargeAuthorization(ctx, &transaction.Request{
+		Email:             email,
+		Amount:            float32(sub.Amount),
+		AuthorizationCode: authorization,
+		Reference:         reference,
+	})
+	if err != nil {
+		var apiErr *response.APIError
+		if errors.As(err, &apiErr) && apiErr.HTTPStatusCode < 500 {
+			r.Retries++
+			m.notify(ctx, Notification{Kind: NotifyRetryFailed, Record: *r, Err: err})
+			return nil
+		}
+
+		// the charge may have gone through: look it up before counting it as declined
+		trx, err = m.Transactions.Verify(ctx, reference)
+		if err != nil {
+			return fmt.Errorf("charge %s: outcome unknown: %w", reference, err)
+		}
+	}
+	return m.settleRetry(ctx, r, trx)
+}
+
+// settleRetry records the outcome of a retried charge. Charges still in progress are errors,
+// so the step is run again, and the charge verified, on the next Run.
+func (m *Manager) settleRetry(ctx context.Context, r *Record, trx *transaction.Transaction) error {
+	switch trx.Status {
+	case "success":
+		r.Retries++
+		r.State = StateRecovered
+		m.notify(ctx, Notification{Kind: NotifyRecovered, Record: *r})
+	case "failed", "abandoned", "reversed":
+		r.Retries++
+		m.notify(ctx, Notification{Kind: NotifyRetryFailed, Record: *r, Err: fmt.Errorf("charge %s: %s", trx.Status, trx.GatewayResponse)})
+	default:
+		return fmt.Errorf("charge %s: %s", trx.Reference, trx.Status)
+	}
+	return nil
+}
+
+// RetryReference returns the transaction reference used by the charge retry at the record's current step.
+// It is the same every time the step is run for the same failed renewal.
+func RetryReference(r *Record) string {
+	code := strings.ReplaceAll(r.SubscriptionCode, "_", "-")
+	return fmt.Sprintf("dunning-%s-%d-%d", code, r.FailedAt.Unix(), r.Step)
+}
+
+func (m *Manager) recover(ctx context.Context, code string) error {
+	r, err := m.Store.Get(ctx, code)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil || r.State != StateDunning {
+		return err
+	}
+
+	r.State = StateRecovered
+	if err := m.save(ctx, r); err != nil {
+		return err
+	}
+	m.notify(ctx, Notification{Kind: NotifyRecovered, Record: *r})
+	return nil
+}
+
+// lock locks the subscription and returns the function unlocking it
+func (m *Manager) lock(code string) func() {
+	mu, _ := m.locks.LoadOrStore(code, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+func (m *Manager) save(ctx context.Context, r *Record) error {
+	r.UpdatedAt = m.Clock.Now()
+	return m.Store.Save(ctx, r)
+}
+
+func (m *Manager) notify(ctx context.Context, n Notification) {
+	if m.Notify != nil {
+		m.Notify(ctx, n)
+	}
+}
+
+// field returns a string field of a subscription reference that was returned as an object
+func field(v interface{}, key string) string {
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	s, _ := obj[key].(string)
+	return s
+}
@@ -0,0 +1,353 @@
+package dunning
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/hub1989/paystack-api-wrapper/configuration"
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"github.com/hub1989/paystack-api-wrapper/subscription"
+	"github.com/hub1989/paystack-api-wrapper/transaction"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+type fakeSubscriptions struct {
+	subscription.Service
+	linksSent int
+	disabled  []string
+}
+
+func (f *fakeSubscriptions) Get(ctx context.Context, idCode string) (*subscription.Subscription, error) {
+	return &subscription.Subscription{
+		SubscriptionCode: idCode,
+		Amount:           50000,
+		EmailToken:       "token_" + idCode,
+		Customer:         map[string]interface{}{"email": "customer@example.com"},
+		Authorization:    map[string]interface{}{"authorization_code": "AUTH_1"},
+	}, nil
+}
+
+func (f *fakeSubscriptions) GenerateUpdateLink(ctx context.Context, subscriptionCode string) (string, error) {
+	return "https://paystack.com/manage/" + subscriptionCode, nil
+}
+
+func (f *fakeSubscriptions) SendUpdateLink(ctx context.Context, subscriptionCode string) (response.Response, error) {
+	f.linksSent++
+	return response.Response{}, nil
+}
+
+func (f *fakeSubscriptions) Disable(ctx context.Context, subscriptionCode, emailToken string) (response.Response, error) {
+	f.disabled = append(f.disabled, subscriptionCode+"/"+emailToken)
+	return response.Response{}, nil
+}
+
+type fakeTransactions struct {
+	transaction.Service
+	statuses []string
+	charges  []*transaction.Request
+	made     map[string]*transaction.Transaction
+	// chargeErr is returned by the next charge, after the charge is made
+	chargeErr error
+	verifyErr error
+	// onCharge, when set, is called while a charge is being made
+	onCharge func()
+}
+
+func (f *fakeTransactions) ChargeAuthorization(ctx context.Context, req *transaction.Request) (*transaction.Transaction, error) {
+	f.charges = append(f.charges, req)
+	if f.onCharge != nil {
+		f.onCharge()
+	}
+	status := f.statuses[0]
+	f.statuses = f.statuses[1:]
+
+	trx := &transaction.Transaction{Reference: req.Reference, Status: status, GatewayResponse: "Declined"}
+	if f.made == nil {
+		f.made = map[string]*transaction.Transaction{}
+	}
+	f.made[req.Reference] = trx
+
+	if err := f.chargeErr; err != nil {
+		f.chargeErr = nil
+		return nil, err
+	}
+	return trx, nil
+}
+
+func (f *fakeTransactions) Verify(ctx context.Context, reference string) (*transaction.Transaction, error) {
+	if err := f.verifyErr; err != nil {
+		f.verifyErr = nil
+		return nil, err
+	}
+	trx, ok := f.made[reference]
+	if !ok {
+		return nil, &response.APIError{HTTPStatusCode: http.StatusBadRequest}
+	}
+	return trx, nil
+}
+
+func newManager(statuses ...string) (*Manager, *fakeClock, *fakeSubscriptions, *fakeTransactions, *[]NotificationKind) {
+	clock := &fakeClock{now: time.Date(2023, time.March, 1, 9, 0, 0, 0, time.UTC)}
+	subs := &fakeSubscriptions{}
+	trxs := &fakeTransactions{statuses: statuses}
+
+	var kinds []NotificationKind
+	m := NewManager(subs, trxs, NewMemoryStore())
+	m.Clock = clock
+	m.Notify = func(ctx context.Context, n Notification) {
+		kinds = append(kinds, n.Kind)
+	}
+	return m, clock, subs, trxs, &kinds
+}
+
+func failedEvent(code string) *Event {
+	e, _ := ParseEvent([]byte(`{"event": "invoice.payment_failed", "data": {"paid": false, "subscription": {"subscription_code": "` + code + `"}}}`))
+	return e
+}
+
+func TestDunningScheduleDisablesUnpaidSubscription(t *testing.T) {
+	m, clock, subs, trxs, kinds := newManager("failed", "failed")
+
+	if err := m.HandleEvent(context.TODO(), failedEvent("SUB_1")); err != nil {
+		t.Fatal(err)
+	}
+	// a repeated failure does not restart the schedule
+	clock.Advance(time.Hour)
+	_ = m.HandleEvent(context.TODO(), failedEvent("SUB_1"))
+
+	for day := 1; day <= 7; day++ {
+		clock.Advance(24 * time.Hour)
+		if err := m.Run(context.TODO()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(trxs.charges) != 2 || trxs.charges[0].AuthorizationCode != "AUTH_1" || trxs.charges[0].Amount != 50000 {
+		t.Errorf("Expected 2 charge retries on AUTH_1, got %+v", trxs.charges)
+	}
+	if subs.linksSent != 1 {
+		t.Errorf("Expected the manage link to be sent once, got %d", subs.linksSent)
+	}
+	if len(subs.disabled) != 1 || subs.disabled[0] != "SUB_1/token_SUB_1" {
+		t.Errorf("Expected SUB_1 to be disabled with its email token, got %v", subs.disabled)
+	}
+
+	want := []NotificationKind{NotifyPaymentFailed, NotifyRetryFailed, NotifyUpdateLink, NotifyRetryFailed, NotifyDisabled}
+	if len(*kinds) != len(want) {
+		t.Fatalf("Expected notifications %v, got %v", want, *kinds)
+	}
+	for i := range want {
+		if (*kinds)[i] != want[i] {
+			t.Errorf("Expected notifications %v, got %v", want, *kinds)
+			break
+		}
+	}
+
+	r, _ := m.Store.Get(context.TODO(), "SUB_1")
+	if r.State != StateDisabled || r.Retries != 2 {
+		t.Errorf("Expected a disabled record after 2 retries, got %+v", r)
+	}
+}
+
+func TestDunningRecovery(t *testing.T) {
+	m, clock, subs, trxs, kinds := newManager("success")
+
+	_ = m.HandleEvent(context.TODO(), failedEvent("SUB_1"))
+	_ = m.HandleEvent(context.TODO(), failedEvent("SUB_2"))
+
+	// SUB_2 is paid by the customer through the manage link
+	paid, _ := ParseEvent([]byte(`{"event": "invoice.update", "data": {"paid": true, "subscription": {"subscription_code": "SUB_2"}}}`))
+	if err := m.HandleEvent(context.TODO(), paid); err != nil {
+		t.Fatal(err)
+	}
+
+	// nothing is due before a day has passed
+	clock.Advance(23 * time.Hour)
+	_ = m.Run(context.TODO())
+	if len(trxs.charges) != 0 {
+		t.Fatalf("Expected no retry before the first step, got %d", len(trxs.charges))
+	}
+
+	clock.Advance(2 * time.Hour)
+	_ = m.Run(context.TODO())
+	clock.Advance(7 * 24 * time.Hour)
+	_ = m.Run(context.TODO())
+
+	if len(trxs.charges) != 1 || subs.linksSent != 0 || len(subs.disabled) != 0 {
+		t.Errorf("Expected a single successful retry, got %d charges, %d links and %v disabled", len(trxs.charges), subs.linksSent, subs.disabled)
+	}
+
+	for _, code := range []string{"SUB_1", "SUB_2"} {
+		if r, _ := m.Store.Get(context.TODO(), code); r.State != StateRecovered {
+			t.Errorf("Expected %s to be recovered, got %+v", code, r)
+		}
+	}
+
+	if (*kinds)[len(*kinds)-1] != NotifyRecovered {
+		t.Errorf("Expected a recovery notification last, got %v", *kinds)
+	}
+}
+
+func TestDunningRunsOneStepPerRun(t *testing.T) {
+	m, clock, subs, trxs, _ := newManager("failed", "failed")
+
+	_ = m.HandleEvent(context.TODO(), failedEvent("SUB_1"))
+
+	// every step is overdue, but only the first one is run
+	clock.Advance(8 * 24 * time.Hour)
+	if err := m.Run(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(trxs.charges) != 1 || subs.linksSent != 0 || len(subs.disabled) != 0 {
+		t.Errorf("Expected a single step to run, got %d charges, %d links and %v disabled", len(trxs.charges), subs.linksSent, subs.disabled)
+	}
+	if r, _ := m.Store.Get(context.TODO(), "SUB_1"); r.Step != 1 || r.State != StateDunning {
+		t.Errorf("Expected the record to be at step 1, got %+v", r)
+	}
+}
+
+func TestDunningEventDuringStepIsKept(t *testing.T) {
+	m, clock, subs, trxs, _ := newManager("failed", "failed")
+
+	_ = m.HandleEvent(context.TODO(), failedEvent("SUB_1"))
+
+	// the customer pays through the manage link while the retry is being charged
+	done := make(chan error, 1)
+	trxs.onCharge = func() {
+		trxs.onCharge = nil
+		paid, _ := ParseEvent([]byte(`{"event": "invoice.update", "data": {"paid": true, "subscription": {"subscription_code": "SUB_1"}}}`))
+		go func() {
+			done <- m.HandleEvent(context.TODO(), paid)
+		}()
+	}
+
+	clock.Advance(25 * time.Hour)
+	if err := m.Run(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	for day := 2; day <= 7; day++ {
+		clock.Advance(24 * time.Hour)
+		if err := m.Run(context.TODO()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(subs.disabled) != 0 || len(trxs.charges) != 1 {
+		t.Errorf("Expected no step after the payment, got %d charges and %v disabled", len(trxs.charges), subs.disabled)
+	}
+	if r, _ := m.Store.Get(context.TODO(), "SUB_1"); r.State != StateRecovered {
+		t.Errorf("Expected SUB_1 to stay recovered, got %+v", r)
+	}
+}
+
+func TestDunningRetryTimeoutIsVerified(t *testing.T) {
+	m, clock, _, trxs, kinds := newManager("success")
+
+	_ = m.HandleEvent(context.TODO(), failedEvent("SUB_1"))
+
+	// the charge goes through, but the response is lost, and so is the first verification
+	trxs.chargeErr = context.DeadlineExceeded
+	trxs.verifyErr = errors.New("connection reset")
+	clock.Advance(25 * time.Hour)
+	if err := m.Run(context.TODO()); err == nil {
+		t.Fatal("Expected the unknown charge outcome to be reported")
+	}
+
+	r, _ := m.Store.Get(context.TODO(), "SUB_1")
+	if r.State != StateDunning || r.Step != 0 || r.LastError == "" {
+		t.Fatalf("Expected the retry step to be kept, got %+v", r)
+	}
+
+	// the next run verifies the same reference instead of charging again
+	if err := m.Run(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(trxs.charges) != 1 || trxs.charges[0].Reference != RetryReference(&Record{SubscriptionCode: "SUB_1", FailedAt: r.FailedAt}) {
+		t.Errorf("Expected a single charge with the step's reference, got %+v", trxs.charges)
+	}
+
+	r, _ = m.Store.Get(context.TODO(), "SUB_1")
+	if r.State != StateRecovered || (*kinds)[len(*kinds)-1] != NotifyRecovered {
+		t.Errorf("Expected SUB_1 to be recovered, got %+v and %v", r, *kinds)
+	}
+}
+
+func TestDunningRetryTransportErrorVerifiesCharge(t *testing.T) {
+	m, clock, _, trxs, kinds := newManager("success")
+
+	_ = m.HandleEvent(context.TODO(), failedEvent("SUB_1"))
+	trxs.chargeErr = context.DeadlineExceeded
+	clock.Advance(25 * time.Hour)
+	if err := m.Run(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, kind := range *kinds {
+		if kind == NotifyRetryFailed {
+			t.Errorf("Expected a charge that went through not to be reported as declined, got %v", *kinds)
+		}
+	}
+
+	if r, _ := m.Store.Get(context.TODO(), "SUB_1"); r.State != StateRecovered {
+		t.Errorf("Expected SUB_1 to be recovered, got %+v", r)
+	}
+}
+
+func TestDunningDisableWireFormat(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": true, "message": "Subscription disabled successfully"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": true,
+			"data":   map[string]interface{}{"subscription_code": "SUB_1", "email_token": "token_SUB_1"},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	c := configuration.NewClient("sk_test", server.Client(), false)
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	clock := &fakeClock{now: time.Date(2023, time.March, 8, 9, 0, 0, 0, time.UTC)}
+	m := NewManager(&subscription.DefaultSubscriptionService{Client: c}, &fakeTransactions{}, NewMemoryStore())
+	m.Clock = clock
+
+	r := &Record{SubscriptionCode: "SUB_1", State: StateDunning, FailedAt: clock.now.Add(-8 * 24 * time.Hour), Step: 3}
+	_ = m.Store.Save(context.TODO(), r)
+	if err := m.Run(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+
+	if body["code"] != "SUB_1" || body["token"] != "token_SUB_1" {
+		t.Errorf("Expected code and token to be sent as strings, got %#v", body)
+	}
+
+	if r, _ := m.Store.Get(context.TODO(), "SUB_1"); r.State != StateDisabled {
+		t.Errorf("Expected SUB_1 to be disabled, got %+v", r)
+	}
+}
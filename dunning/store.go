@@ -0,0 +1,69 @@
+package dunning
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+)
+
+// ErrNotFound is returned by a Store when no record has the given subscription code
+var ErrNotFound = errors.New("dunning: not found")
+
+// Store persists dunning records. Implementations must be safe for concurrent use.
+type Store interface {
+	// Save creates or replaces the record of the same subscription
+	Save(ctx context.Context, r *Record) error
+	// Get returns the record of the given subscription, or ErrNotFound
+	Get(ctx context.Context, subscriptionCode string) (*Record, error)
+	// List returns the records in the given state
+	List(ctx context.Context, state State) ([]*Record, error)
+}
+
+// MemoryStore is a Store keeping records in memory, for tests and single process deployments
+type MemoryStore struct {
+	mu      sync.RWMutex
+	records map[string]Record
+}
+
+// NewMemoryStore creates an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: map[string]Record{}}
+}
+
+func (m *MemoryStore) Save(ctx context.Context, r *Record) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.records[r.SubscriptionCode] = *r
+	return nil
+}
+
+func (m *MemoryStore) Get(ctx context.Context, subscriptionCode string) (*Record, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	r, ok := m.records[subscriptionCode]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &r, nil
+}
+
+func (m *MemoryStore) List(ctx context.Context, state State) ([]*Record, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var records []*Record
+	for _, r := range m.records {
+		if r.State != state {
+			continue
+		}
+		r := r
+		records = append(records, &r)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].FailedAt.Before(records[j].FailedAt)
+	})
+	return records, nil
+}
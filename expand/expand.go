@@ -0,0 +1,211 @@
+package expand
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hub1989/paystack-api-wrapper/client"
+	"github.com/hub1989/paystack-api-wrapper/customer"
+	"github.com/hub1989/paystack-api-wrapper/plan"
+	"github.com/hub1989/paystack-api-wrapper/subaccount"
+	"github.com/hub1989/paystack-api-wrapper/subscription"
+	"github.com/hub1989/paystack-api-wrapper/transaction"
+	"github.com/hub1989/paystack-api-wrapper/transfer"
+	"strconv"
+)
+
+// ErrNoService is returned when a reference needs a service the Expander was not given
+var ErrNoService = errors.New("expand: no service to load the reference")
+
+// Expander loads the resources referenced by subscriptions, transfers and transactions.
+// Lookups go through Cache, so expanding a page of resources that share
+// the same plan or customer only fetches each of them once.
+type Expander struct {
+	Plans       plan.Service
+	Customers   customer.Service
+	SubAccounts subaccount.Service
+	Transfers   transfer.Service
+	// Cache holds the fetched resources. New uses the client's cache; a nil Cache caches nothing.
+	Cache *client.Cache
+}
+
+// Subscription is a subscription together with the plan and customer it references
+type Subscription struct {
+	*subscription.Subscription
+	Plan     *plan.Plan
+	Customer *customer.Customer
+}
+
+// Transfer is a transfer together with its recipient
+type Transfer struct {
+	*transfer.Transfer
+	Recipient *transfer.Recipient
+}
+
+// Transaction is a transaction together with the subaccount it was split with
+type Transaction struct {
+	*transaction.Transaction
+	SubAccount *subaccount.SubAccount
+}
+
+// New creates an Expander backed by the default services of c.
+// Without a client the Expander has no services, and expanding a reference returns ErrNoService.
+func New(c *client.Client) *Expander {
+	if c == nil {
+		return &Expander{}
+	}
+	return &Expander{
+		Plans:       &plan.DefaultPlanService{Client: c},
+		Customers:   &customer.DefaultCustomerService{Client: c},
+		SubAccounts: &subaccount.DefaultSubAccountService{Client: c},
+		Transfers:   &transfer.DefaultTransferService{Client: c},
+		Cache:       c.Cache,
+	}
+}
+
+// Subscription fetches the plan and customer referenced by sub
+func (e *Expander) Subscription(ctx context.Context, sub *subscription.Subscription) (*Subscription, error) {
+	expanded := &Subscription{Subscription: sub}
+
+	if ref, ok := reference(string(sub.Plan), "plan_code"); ok {
+		p, err := e.plan(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		expanded.Plan = p
+	}
+
+	if ref, ok := reference(sub.Customer, "customer_code"); ok {
+		cust, err := e.customer(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		expanded.Customer = cust
+	}
+
+	return expanded, nil
+}
+
+// Subscriptions expands every subscription in subs
+func (e *Expander) Subscriptions(ctx context.Context, subs []subscription.Subscription) ([]Subscription, error) {
+	expanded := make([]Subscription, 0, len(subs))
+	for i := range subs {
+		sub, err := e.Subscription(ctx, &subs[i])
+		if err != nil {
+			return nil, err
+		}
+		expanded = append(expanded, *sub)
+	}
+	return expanded, nil
+}
+
+// Transfer fetches the recipient referenced by t
+func (e *Expander) Transfer(ctx context.Context, t *transfer.Transfer) (*Transfer, error) {
+	expanded := &Transfer{Transfer: t}
+
+	if ref, ok := reference(t.Recipient, "recipient_code"); ok {
+		recipient, err := e.recipient(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		expanded.Recipient = recipient
+	}
+
+	return expanded, nil
+}
+
+// Transaction fetches the subaccount referenced by txn
+func (e *Expander) Transaction(ctx context.Context, txn *transaction.Transaction) (*Transaction, error) {
+	expanded := &Transaction{Transaction: txn}
+
+	ref := txn.SubAccount.SubAccountCode
+	if txn.SubAccount.ID != 0 {
+		ref = strconv.Itoa(txn.SubAccount.ID)
+	}
+	if ref != "" {
+		acc, err := e.subAccount(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		expanded.SubAccount = acc
+	}
+
+	return expanded, nil
+}
+
+func (e *Expander) plan(ctx context.Context, ref string) (*plan.Plan, error) {
+	if e.Plans == nil {
+		return nil, fmt.Errorf("%w: plan %s", ErrNoService, ref)
+	}
+	v, err := e.Cache.Fetch("plan:"+ref, func() (interface{}, error) {
+		return e.Plans.Get(ctx, ref)
+	})
+	if err != nil {
+		return nil, err
+	}
+	p := *v.(*plan.Plan)
+	return &p, nil
+}
+
+func (e *Expander) customer(ctx context.Context, ref string) (*customer.Customer, error) {
+	if e.Customers == nil {
+		return nil, fmt.Errorf("%w: customer %s", ErrNoService, ref)
+	}
+	v, err := e.Cache.Fetch("customer:"+ref, func() (interface{}, error) {
+		return e.Customers.Get(ctx, ref)
+	})
+	if err != nil {
+		return nil, err
+	}
+	cust := *v.(*customer.Customer)
+	return &cust, nil
+}
+
+func (e *Expander) recipient(ctx context.Context, ref string) (*transfer.Recipient, error) {
+	if e.Transfers == nil {
+		return nil, fmt.Errorf("%w: recipient %s", ErrNoService, ref)
+	}
+	v, err := e.Cache.Fetch("recipient:"+ref, func() (interface{}, error) {
+		return e.Transfers.GetRecipient(ctx, ref)
+	})
+	if err != nil {
+		return nil, err
+	}
+	recipient := *v.(*transfer.Recipient)
+	return &recipient, nil
+}
+
+func (e *Expander) subAccount(ctx context.Context, ref string) (*subaccount.SubAccount, error) {
+	if e.SubAccounts == nil {
+		return nil, fmt.Errorf("%w: subaccount %s", ErrNoService, ref)
+	}
+	v, err := e.Cache.Fetch("subaccount:"+ref, func() (interface{}, error) {
+		return e.SubAccounts.Get(ctx, ref)
+	})
+	if err != nil {
+		return nil, err
+	}
+	acc := *v.(*subaccount.SubAccount)
+	return &acc, nil
+}
+
+// reference returns the ID or code a field points to.
+// Depending on the endpoint, Paystack returns references as codes, numeric IDs or embedded objects.
+func reference(v interface{}, codeKey string) (string, bool) {
+	switch ref := v.(type) {
+	case string:
+		return ref, ref != ""
+	case int:
+		return strconv.Itoa(ref), ref != 0
+	case float64:
+		return strconv.FormatInt(int64(ref), 10), ref != 0
+	case map[string]interface{}:
+		if code, ok := ref[codeKey].(string); ok && code != "" {
+			return code, true
+		}
+		if id, ok := ref["id"]; ok {
+			return reference(id, codeKey)
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,194 @@
+package expand
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/hub1989/paystack-api-wrapper/client"
+	"github.com/hub1989/paystack-api-wrapper/configuration"
+	"github.com/hub1989/paystack-api-wrapper/subscription"
+	"github.com/hub1989/paystack-api-wrapper/transaction"
+	"github.com/hub1989/paystack-api-wrapper/transfer"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakePaystack struct {
+	mu           sync.Mutex
+	calls        map[string]int
+	subscription map[string]interface{}
+}
+
+func (f *fakePaystack) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.calls[r.URL.Path]++
+	f.mu.Unlock()
+
+	data := map[string]interface{}{}
+	switch r.URL.Path {
+	case "/subscription/SUB_one":
+		data = f.subscription
+	case "/plan/PLN_monthly", "/plan/1":
+		data = map[string]interface{}{"id": 1, "plan_code": "PLN_monthly", "name": "Monthly"}
+	case "/customer/CUS_one":
+		data = map[string]interface{}{"id": 10, "customer_code": "CUS_one", "email": "one@example.com"}
+	case "/customer/CUS_two":
+		data = map[string]interface{}{"id": 20, "customer_code": "CUS_two", "email": "two@example.com"}
+	case "/transferrecipient/RCP_one":
+		data = map[string]interface{}{"id": 5, "recipient_code": "RCP_one", "name": "Recipient"}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": false, "message": "not found"})
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": true, "data": data})
+}
+
+func newExpander(t *testing.T) (*Expander, *fakePaystack, *client.Client) {
+	fake := &fakePaystack{calls: map[string]int{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	c := configuration.NewClient("sk_test", server.Client(), false)
+	c.BaseURL, _ = url.Parse(server.URL)
+	return New(c), fake, c
+}
+
+func TestExpandSubscriptionsUsesCache(t *testing.T) {
+	e, fake, _ := newExpander(t)
+
+	var subs []subscription.Subscription
+	for i := 0; i < 100; i++ {
+		cust := "CUS_one"
+		if i%2 == 0 {
+			cust = "CUS_two"
+		}
+		subs = append(subs, subscription.Subscription{
+			ID:       i,
+			Plan:     "PLN_monthly",
+			Customer: map[string]interface{}{"customer_code": cust},
+		})
+	}
+
+	expanded, err := e.Subscriptions(context.TODO(), subs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(expanded) != len(subs) {
+		t.Fatalf("Expected %d expanded subscriptions, got %d", len(subs), len(expanded))
+	}
+
+	if expanded[0].Plan.Name != "Monthly" || expanded[0].Customer.Email != "two@example.com" {
+		t.Errorf("Unexpected expansion %+v %+v", expanded[0].Plan, expanded[0].Customer)
+	}
+
+	if expanded[1].Customer.CustomerCode != "CUS_one" {
+		t.Errorf("Expected customer CUS_one, got %v", expanded[1].Customer.CustomerCode)
+	}
+
+	for path, n := range fake.calls {
+		if n != 1 {
+			t.Errorf("Expected a single request to %s, got %d", path, n)
+		}
+	}
+}
+
+func TestExpandTransfer(t *testing.T) {
+	e, _, _ := newExpander(t)
+
+	expanded, err := e.Transfer(context.TODO(), &transfer.Transfer{Recipient: "RCP_one"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expanded.Recipient == nil || expanded.Recipient.Name != "Recipient" {
+		t.Errorf("Expected recipient to be expanded, got %+v", expanded.Recipient)
+	}
+}
+
+func TestExpandMissingReference(t *testing.T) {
+	e, fake, _ := newExpander(t)
+
+	expanded, err := e.Subscription(context.TODO(), &subscription.Subscription{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expanded.Plan != nil || expanded.Customer != nil || len(fake.calls) != 0 {
+		t.Errorf("Expected nothing to be fetched, got %+v", fake.calls)
+	}
+}
+
+func TestExpandDecodedSubscription(t *testing.T) {
+	e, fake, c := newExpander(t)
+	subscriptions := &subscription.DefaultSubscriptionService{Client: c}
+
+	// fetch returns the plan object, which is kept as the plan ID
+	fake.subscription = map[string]interface{}{
+		"subscription_code": "SUB_one",
+		"plan":              map[string]interface{}{"id": 1, "plan_code": "PLN_monthly", "name": "Monthly"},
+		"customer":          map[string]interface{}{"customer_code": "CUS_one"},
+	}
+	sub, err := subscriptions.Get(context.TODO(), "SUB_one")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sub.Plan != "1" {
+		t.Fatalf("Expected plan ID 1, got %q", sub.Plan)
+	}
+
+	expanded, err := e.Subscription(context.TODO(), sub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expanded.Plan == nil || expanded.Plan.Name != "Monthly" {
+		t.Errorf("Expected plan to be expanded, got %+v", expanded.Plan)
+	}
+}
+
+func TestReferenceDecodingIsScoped(t *testing.T) {
+	// only reference fields take the ID of an embedded object
+	sub := &subscription.Subscription{}
+	err := client.Decode(map[string]interface{}{"subscription_code": map[string]interface{}{"id": "SUB_one"}}, sub)
+	if err == nil || sub.SubscriptionCode != "" {
+		t.Errorf("Expected an object not to decode into a plain string field, got %q, %v", sub.SubscriptionCode, err)
+	}
+}
+
+func TestExpandWithExplicitCache(t *testing.T) {
+	e, fake, _ := newExpander(t)
+
+	literal := &Expander{Plans: e.Plans, Cache: client.NewCache(time.Minute)}
+	for i := 0; i < 3; i++ {
+		if _, err := literal.Subscription(context.TODO(), &subscription.Subscription{Plan: "PLN_monthly"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if fake.calls["/plan/PLN_monthly"] != 1 {
+		t.Errorf("Expected the plan to be fetched once through the cache, got %d", fake.calls["/plan/PLN_monthly"])
+	}
+}
+
+func TestExpandWithoutServices(t *testing.T) {
+	for _, e := range []*Expander{{}, New(nil)} {
+		if _, err := e.Subscription(context.TODO(), &subscription.Subscription{Plan: "PLN_monthly"}); !errors.Is(err, ErrNoService) {
+			t.Errorf("Expected ErrNoService for the plan, got %v", err)
+		}
+		if _, err := e.Transfer(context.TODO(), &transfer.Transfer{Recipient: "RCP_one"}); !errors.Is(err, ErrNoService) {
+			t.Errorf("Expected ErrNoService for the recipient, got %v", err)
+		}
+		txn := &transaction.Transaction{}
+		txn.SubAccount.SubAccountCode = "ACCT_one"
+		if _, err := e.Transaction(context.TODO(), txn); !errors.Is(err, ErrNoService) {
+			t.Errorf("Expected ErrNoService for the subaccount, got %v", err)
+		}
+	}
+}
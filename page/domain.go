@@ -1,6 +1,9 @@
 package page
 
-import "github.com/hub1989/paystack-api-wrapper/response"
+import (
+	"github.com/hub1989/paystack-api-wrapper/product"
+	"github.com/hub1989/paystack-api-wrapper/response"
+)
 
 // Page represents a Paystack page
 // For more details see https://developers.paystack.co/v1.0/reference#create-page
@@ -18,6 +21,8 @@ type Page struct {
 	Active       bool                `json:"active,omitempty"`
 	RedirectURL  string              `json:"redirect_url,omitempty"`
 	CustomFields []map[string]string `json:"custom_fields,omitempty"`
+	Type         string              `json:"type,omitempty"`
+	Products     []product.Product   `json:"products,omitempty"`
 }
 
 // List is a list object for pages.
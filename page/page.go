@@ -2,17 +2,26 @@ package page
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hub1989/paystack-api-wrapper/client"
+	"github.com/hub1989/paystack-api-wrapper/response"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
+// ErrInvalidSlug is returned by CheckSlugAvailability for an empty slug
+var ErrInvalidSlug = errors.New("page: invalid slug")
+
 type Service interface {
 	Create(ctx context.Context, page *Page) (*Page, error)
 	Update(ctx context.Context, page *Page) (*Page, error)
 	Get(ctx context.Context, id int) (*Page, error)
 	List(ctx context.Context) (*List, error)
 	ListN(ctx context.Context, count, offset int) (*List, error)
+	AddProducts(ctx context.Context, id int, productIDs []int) (*Page, error)
+	CheckSlugAvailability(ctx context.Context, slug string) (bool, error)
 }
 
 // DefaultPageService handles operations related to the page
@@ -65,3 +74,45 @@ func (s *DefaultPageService) ListN(ctx context.Context, count, offset int) (*Lis
 	err := s.Client.Call(ctx, http.MethodGet, u, nil, pg)
 	return pg, err
 }
+
+// AddProducts adds products to a page. The page is turned into a product page if it is not one already.
+// For more details see https://paystack.com/docs/api/page/#add-products
+func (s *DefaultPageService) AddProducts(ctx context.Context, id int, productIDs []int) (*Page, error) {
+	u := fmt.Sprintf("/page/%d/product", id)
+	reqBody := struct {
+		Product []int `json:"product"`
+	}{
+		Product: productIDs,
+	}
+	pg := &Page{}
+	err := s.Client.Call(ctx, http.MethodPost, u, reqBody, pg)
+	return pg, err
+}
+
+// CheckSlugAvailability reports whether slug can be used for a new page.
+// Other errors, such as a malformed slug, are returned as they are.
+// For more details see https://paystack.com/docs/api/page/#check-slug
+func (s *DefaultPageService) CheckSlugAvailability(ctx context.Context, slug string) (bool, error) {
+	if strings.TrimSpace(slug) == "" {
+		return false, fmt.Errorf("%w: slug is empty", ErrInvalidSlug)
+	}
+
+	u := fmt.Sprintf("/page/check_slug_availability/%s", url.PathEscape(slug))
+	resp := response.Response{}
+	err := s.Client.Call(ctx, http.MethodGet, u, nil, &resp)
+
+	// Paystack answers a taken slug with a 400 saying the slug is not available
+	var apiErr *response.APIError
+	if errors.As(err, &apiErr) && apiErr.HTTPStatusCode == http.StatusBadRequest && slugTaken(apiErr.Details.Message) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// slugTaken reports whether message is the documented answer for a slug that is in use
+func slugTaken(message string) bool {
+	return strings.Contains(strings.ToLower(message), "not available")
+}
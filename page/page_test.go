@@ -2,8 +2,14 @@ package page
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"github.com/hub1989/paystack-api-wrapper/client"
 	"github.com/hub1989/paystack-api-wrapper/configuration"
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 )
 
@@ -44,3 +50,62 @@ func TestPageCRUD(t *testing.T) {
 		t.Errorf("Expected Page list, got %d, returned error %v", len(pages.Values), err)
 	}
 }
+
+func TestCheckSlugAvailability(t *testing.T) {
+	page1 := &Page{
+		Name:        "Demo slug page",
+		Description: "Paystack Go client test page",
+	}
+
+	page, err := service.Create(context.TODO(), page1)
+	if err != nil {
+		t.Errorf("CREATE Page returned error: %v", err)
+	}
+
+	available, err := service.CheckSlugAvailability(context.TODO(), page.Slug)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if available {
+		t.Errorf("Expected slug %v of an existing page to be taken", page.Slug)
+	}
+}
+
+func TestCheckSlugAvailabilityErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		message := "Slug is not available"
+		switch r.URL.Path {
+		case "/page/check_slug_availability/bad slug":
+			message = "Slug is invalid"
+		case "/page/check_slug_availability/other":
+			message = "Page already exists for this integration"
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": false, "message": message})
+	}))
+	t.Cleanup(server.Close)
+
+	offline := configuration.NewClient("sk_test", server.Client(), false)
+	offline.BaseURL, _ = url.Parse(server.URL)
+	pages := &DefaultPageService{Client: offline}
+
+	available, err := pages.CheckSlugAvailability(context.TODO(), "taken")
+	if err != nil || available {
+		t.Errorf("Expected a taken slug, got %v (%v)", available, err)
+	}
+
+	var apiErr *response.APIError
+	if _, err := pages.CheckSlugAvailability(context.TODO(), "bad slug"); !errors.As(err, &apiErr) {
+		t.Errorf("Expected a malformed slug to return the API error, got %v", err)
+	}
+
+	// only the documented answer means the slug is taken
+	if _, err := pages.CheckSlugAvailability(context.TODO(), "other"); !errors.As(err, &apiErr) {
+		t.Errorf("Expected an undocumented answer to return the API error, got %v", err)
+	}
+
+	if _, err := pages.CheckSlugAvailability(context.TODO(), " "); !errors.Is(err, ErrInvalidSlug) {
+		t.Errorf("Expected ErrInvalidSlug, got %v", err)
+	}
+}
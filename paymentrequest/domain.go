@@ -0,0 +1,121 @@
+package paymentrequest
+
+import (
+	"github.com/hub1989/paystack-api-wrapper/client"
+	"github.com/hub1989/paystack-api-wrapper/customer"
+	"github.com/hub1989/paystack-api-wrapper/response"
+)
+
+// Status is the payment status of a payment request
+type Status string
+
+const (
+	StatusPending Status = "pending"
+	StatusSuccess Status = "success"
+	StatusFailed  Status = "failed"
+)
+
+// PaymentRequest is the resource representing a Paystack payment request (invoice).
+// For more details see https://paystack.com/docs/api/payment-request/
+type PaymentRequest struct {
+	ID               int               `json:"id,omitempty"`
+	CreatedAt        string            `json:"createdAt,omitempty"`
+	UpdatedAt        string            `json:"updatedAt,omitempty"`
+	Domain           string            `json:"domain,omitempty"`
+	Integration      int               `json:"integration,omitempty"`
+	RequestCode      string            `json:"request_code,omitempty"`
+	OfflineReference string            `json:"offline_reference,omitempty"`
+	Amount           int               `json:"amount,omitempty"`
+	Currency         string            `json:"currency,omitempty"`
+	DueDate          string            `json:"due_date,omitempty"`
+	Description      string            `json:"description,omitempty"`
+	LineItems        []LineItem        `json:"line_items,omitempty"`
+	Tax              []Tax             `json:"tax,omitempty"`
+	Status           Status            `json:"status,omitempty"`
+	Paid             bool              `json:"paid,omitempty"`
+	PaidAt           string            `json:"paid_at,omitempty"`
+	HasInvoice       bool              `json:"has_invoice,omitempty"`
+	InvoiceNumber    int               `json:"invoice_number,omitempty"`
+	PDFURL           string            `json:"pdf_url,omitempty"`
+	Archived         bool              `json:"archived,omitempty"`
+	Metadata         client.Metadata   `json:"metadata,omitempty"`
+	Notifications    []Notification    `json:"notifications,omitempty"`
+	Customer         customer.Customer `json:"customer,omitempty"`
+}
+
+// LineItem is a billed item of a payment request. Amount is in the currency's subunit.
+type LineItem struct {
+	Name     string `json:"name,omitempty"`
+	Amount   int    `json:"amount,omitempty"`
+	Quantity int    `json:"quantity,omitempty"`
+}
+
+// Tax is a tax charged on a payment request. Amount is in the currency's subunit.
+type Tax struct {
+	Name   string `json:"name,omitempty"`
+	Amount int    `json:"amount,omitempty"`
+}
+
+// Notification records a payment request notification sent to the customer
+type Notification struct {
+	SentAt  string `json:"sent_at,omitempty"`
+	Channel string `json:"channel,omitempty"`
+}
+
+// Request represents a request to create or update a payment request
+type Request struct {
+	// customer ID or code
+	Customer string `json:"customer,omitempty"`
+	// Amount is required when there are no line items
+	Amount      int        `json:"amount,omitempty"`
+	Currency    string     `json:"currency,omitempty"`
+	DueDate     string     `json:"due_date,omitempty"`
+	Description string     `json:"description,omitempty"`
+	LineItems   []LineItem `json:"line_items,omitempty"`
+	Tax         []Tax      `json:"tax,omitempty"`
+	// SendNotification defaults to true on Paystack
+	SendNotification *bool  `json:"send_notification,omitempty"`
+	Draft            bool   `json:"draft,omitempty"`
+	HasInvoice       bool   `json:"has_invoice,omitempty"`
+	InvoiceNumber    int    `json:"invoice_number,omitempty"`
+	SplitCode        string `json:"split_code,omitempty"`
+}
+
+// ListOptions filters the payment requests returned by List
+type ListOptions struct {
+	// Customer is the customer ID
+	Customer       string
+	Status         Status
+	Currency       string
+	IncludeArchive bool
+	// From and To limit the creation date range, e.g. 2016-09-21T00:00:00.000Z
+	From    string
+	To      string
+	PerPage int
+	Page    int
+}
+
+// List is a list object for payment requests.
+type List struct {
+	Meta   response.ListMeta
+	Values []PaymentRequest `json:"data"`
+}
+
+// Total is an amount in a single currency
+type Total struct {
+	Currency string `json:"currency,omitempty"`
+	Amount   int    `json:"amount,omitempty"`
+}
+
+// Totals are the amounts of pending, successful and all payment requests, per currency
+type Totals struct {
+	Pending    []Total `json:"pending,omitempty"`
+	Successful []Total `json:"successful,omitempty"`
+	Total      []Total `json:"total,omitempty"`
+}
+
+// Result is the answer to an action on a payment request that does not return the payment request
+type Result struct {
+	Status  bool   `json:"status"`
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,136 @@
+package paymentrequest
+
+import (
+	"context"
+	"fmt"
+	"github.com/hub1989/paystack-api-wrapper/client"
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type Service interface {
+	Create(ctx context.Context, req *Request) (*PaymentRequest, error)
+	List(ctx context.Context, opts *ListOptions) (*List, error)
+	Get(ctx context.Context, idOrCode string) (*PaymentRequest, error)
+	Verify(ctx context.Context, code string) (*PaymentRequest, error)
+	SendNotification(ctx context.Context, code string) (*Result, error)
+	Totals(ctx context.Context) (*Totals, error)
+	Finalize(ctx context.Context, code string, sendNotification bool) (*PaymentRequest, error)
+	Update(ctx context.Context, idOrCode string, req *Request) (*PaymentRequest, error)
+	Archive(ctx context.Context, code string) (*Result, error)
+}
+
+// DefaultPaymentRequestService handles operations related to payment requests
+// For more details see https://paystack.com/docs/api/payment-request/
+type DefaultPaymentRequestService struct {
+	*client.Client
+}
+
+// Create creates a payment request. Set Draft to save it without sending it to the customer.
+// For more details see https://paystack.com/docs/api/payment-request/#create
+func (s *DefaultPaymentRequestService) Create(ctx context.Context, req *Request) (*PaymentRequest, error) {
+	return s.call(ctx, http.MethodPost, "/paymentrequest", req)
+}
+
+// List returns the payment requests matching opts
+// For more details see https://paystack.com/docs/api/payment-request/#list
+func (s *DefaultPaymentRequestService) List(ctx context.Context, opts *ListOptions) (*List, error) {
+	params := url.Values{}
+	if opts != nil {
+		params.Set("customer", opts.Customer)
+		params.Set("status", string(opts.Status))
+		params.Set("currency", opts.Currency)
+		if opts.IncludeArchive {
+			params.Set("include_archive", "true")
+		}
+		params.Set("from", opts.From)
+		params.Set("to", opts.To)
+		if opts.PerPage > 0 {
+			params.Set("perPage", strconv.Itoa(opts.PerPage))
+		}
+		if opts.Page > 0 {
+			params.Set("page", strconv.Itoa(opts.Page))
+		}
+	}
+
+	requests := &List{}
+	err := s.Client.Call(ctx, http.MethodGet, client.AddQuery("/paymentrequest", params), nil, requests)
+	return requests, err
+}
+
+// Get returns the details of a payment request
+// For more details see https://paystack.com/docs/api/payment-request/#fetch
+func (s *DefaultPaymentRequestService) Get(ctx context.Context, idOrCode string) (*PaymentRequest, error) {
+	return s.call(ctx, http.MethodGet, fmt.Sprintf("/paymentrequest/%s", idOrCode), nil)
+}
+
+// Verify returns the payment request with its payment status
+// For more details see https://paystack.com/docs/api/payment-request/#verify
+func (s *DefaultPaymentRequestService) Verify(ctx context.Context, code string) (*PaymentRequest, error) {
+	return s.call(ctx, http.MethodGet, fmt.Sprintf("/paymentrequest/verify/%s", code), nil)
+}
+
+// SendNotification sends the payment request to the customer again
+// For more details see https://paystack.com/docs/api/payment-request/#send-notification
+func (s *DefaultPaymentRequestService) SendNotification(ctx context.Context, code string) (*Result, error) {
+	u := fmt.Sprintf("/paymentrequest/notify/%s", code)
+	result := &Result{}
+	err := s.Client.Call(ctx, http.MethodPost, u, nil, result)
+	return result, err
+}
+
+// Totals returns the amounts of pending and successful payment requests
+// For more details see https://paystack.com/docs/api/payment-request/#total
+func (s *DefaultPaymentRequestService) Totals(ctx context.Context) (*Totals, error) {
+	totals := &Totals{}
+	err := s.Client.Call(ctx, http.MethodGet, "/paymentrequest/totals", nil, totals)
+	return totals, err
+}
+
+// Finalize publishes a draft payment request
+// For more details see https://paystack.com/docs/api/payment-request/#finalize
+func (s *DefaultPaymentRequestService) Finalize(ctx context.Context, code string, sendNotification bool) (*PaymentRequest, error) {
+	reqBody := struct {
+		SendNotification bool `json:"send_notification"`
+	}{
+		SendNotification: sendNotification,
+	}
+	return s.call(ctx, http.MethodPost, fmt.Sprintf("/paymentrequest/finalize/%s", code), reqBody)
+}
+
+// Update updates a payment request
+// For more details see https://paystack.com/docs/api/payment-request/#update
+func (s *DefaultPaymentRequestService) Update(ctx context.Context, idOrCode string, req *Request) (*PaymentRequest, error) {
+	return s.call(ctx, http.MethodPut, fmt.Sprintf("/paymentrequest/%s", idOrCode), req)
+}
+
+// Archive archives a payment request so it is no longer listed or payable
+// For more details see https://paystack.com/docs/api/payment-request/#archive
+func (s *DefaultPaymentRequestService) Archive(ctx context.Context, code string) (*Result, error) {
+	u := fmt.Sprintf("/paymentrequest/archive/%s", code)
+	result := &Result{}
+	err := s.Client.Call(ctx, http.MethodPost, u, nil, result)
+	return result, err
+}
+
+// call requests a single payment request.
+// Create, Update and Finalize return the customer as an ID while Fetch and Verify return the object,
+// so the customer is normalised before decoding.
+func (s *DefaultPaymentRequestService) call(ctx context.Context, method, path string, body interface{}) (*PaymentRequest, error) {
+	resp := response.Response{}
+	if err := s.Client.Call(ctx, method, path, body, &resp); err != nil {
+		return &PaymentRequest{}, err
+	}
+
+	switch cust := resp["customer"].(type) {
+	case map[string]interface{}, nil:
+	default:
+		resp["customer"] = map[string]interface{}{"id": cust}
+	}
+
+	pr := &PaymentRequest{}
+	err := client.Decode(resp, pr)
+	return pr, err
+}
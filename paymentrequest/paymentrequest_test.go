@@ -0,0 +1,113 @@
+package paymentrequest
+
+import (
+	"context"
+	"github.com/hub1989/paystack-api-wrapper/client"
+	"github.com/hub1989/paystack-api-wrapper/configuration"
+	"github.com/hub1989/paystack-api-wrapper/customer"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+)
+
+var c *client.Client
+var service *DefaultPaymentRequestService
+var customerService *customer.DefaultCustomerService
+
+func init() {
+	apiKey := client.MustGetTestKey()
+	c = configuration.NewClient(apiKey, nil, true)
+	service = &DefaultPaymentRequestService{Client: c}
+	customerService = &customer.DefaultCustomerService{Client: c}
+}
+
+func TestPaymentRequestCRUD(t *testing.T) {
+	cust, err := customerService.Create(context.TODO(), &customer.Customer{
+		FirstName: "User123",
+		LastName:  "AdminUser",
+		Email:     "user123-invoice@gmail.com",
+	})
+	if err != nil {
+		t.Errorf("CREATE Customer returned error: %v", err)
+	}
+
+	send := false
+	req := &Request{
+		Customer:         cust.CustomerCode,
+		Description:      "Consulting, March",
+		LineItems:        []LineItem{{Name: "Consulting", Amount: 500000, Quantity: 2}},
+		Tax:              []Tax{{Name: "VAT", Amount: 75000}},
+		SendNotification: &send,
+		Draft:            true,
+	}
+
+	pr, err := service.Create(context.TODO(), req)
+	if err != nil {
+		t.Fatalf("CREATE Payment request returned error: %v", err)
+	}
+
+	if pr.RequestCode == "" {
+		t.Errorf("Expected Payment request code to be set")
+	}
+
+	pr, err = service.Get(context.TODO(), pr.RequestCode)
+	if err != nil {
+		t.Errorf("GET Payment request returned error: %v", err)
+	}
+
+	if pr.Customer.CustomerCode != cust.CustomerCode {
+		t.Errorf("Expected Payment request customer %v, got %v", cust.CustomerCode, pr.Customer.CustomerCode)
+	}
+
+	requests, err := service.List(context.TODO(), &ListOptions{Customer: strconv.Itoa(pr.Customer.ID)})
+	if err != nil {
+		t.Errorf("Expected Payment request list, got %d, returned error %v", len(requests.Values), err)
+	}
+
+	if _, err = service.Archive(context.TODO(), pr.RequestCode); err != nil {
+		t.Errorf("ARCHIVE Payment request returned error: %v", err)
+	}
+}
+
+func TestPaymentRequestTotals(t *testing.T) {
+	_, err := service.Totals(context.TODO())
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPaymentRequestActions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/paymentrequest/notify/PRQ_1":
+			_, _ = w.Write([]byte(`{"status": true, "message": "Notification sent"}`))
+		case "/paymentrequest/archive/PRQ_1":
+			_, _ = w.Write([]byte(`{"status": true, "message": "Payment request has been archived"}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status": false, "message": "Customer is required"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c := configuration.NewClient("sk_test", server.Client(), false)
+	c.BaseURL, _ = url.Parse(server.URL)
+	service := &DefaultPaymentRequestService{Client: c}
+
+	sent, err := service.SendNotification(context.TODO(), "PRQ_1")
+	if err != nil || !sent.Status || sent.Message != "Notification sent" {
+		t.Errorf("Expected the notification to be sent, got %+v, %v", sent, err)
+	}
+
+	archived, err := service.Archive(context.TODO(), "PRQ_1")
+	if err != nil || !archived.Status || archived.Message != "Payment request has been archived" {
+		t.Errorf("Expected the payment request to be archived, got %+v, %v", archived, err)
+	}
+
+	pr, err := service.Create(context.TODO(), &Request{})
+	if err == nil || pr == nil {
+		t.Errorf("Expected an empty payment request with the error, got %v, %v", pr, err)
+	}
+}
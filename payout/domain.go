@@ -0,0 +1,32 @@
+package payout
+
+import (
+	"github.com/hub1989/paystack-api-wrapper/transfer"
+	"time"
+)
+
+// Payout is a transfer initiated through an Orchestrator, as recorded in its Store
+type Payout struct {
+	// Reference is the transfer reference and the key of the payout in the store
+	Reference    string
+	TransferCode string
+	Request      transfer.Request
+	// Status is the last known transfer status. It is empty until Paystack has confirmed the transfer.
+	Status transfer.Status
+	// OTPResends counts the ResendOTP calls made for the transfer
+	OTPResends int
+	// Error is the reason the transfer could not be initiated
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// AwaitingOTP reports whether the payout needs an OTP to be finalized
+func (p *Payout) AwaitingOTP() bool {
+	return p.Status == transfer.StatusOTP
+}
+
+// Settled reports whether the payout has reached a final state
+func (p *Payout) Settled() bool {
+	return p.Status.Terminal()
+}
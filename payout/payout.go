@@ -0,0 +1,249 @@
+package payout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"github.com/hub1989/paystack-api-wrapper/transfer"
+	"net/http"
+	"time"
+)
+
+// DefaultMaxOTPResends is the number of times the OTP of a transfer can be resent
+const DefaultMaxOTPResends = 3
+
+var (
+	// ErrNotAwaitingOTP is returned by Finalize and ResendOTP for payouts that do not need an OTP
+	ErrNotAwaitingOTP = errors.New("payout: transfer is not awaiting an otp")
+	// ErrResendLimit is returned by ResendOTP once the OTP has been resent MaxOTPResends times
+	ErrResendLimit = errors.New("payout: otp resend limit reached")
+)
+
+// SaveError is returned when a payout cannot be saved after its transfer call failed.
+// errors.Is and errors.As match both the transfer error and the Store error.
+type SaveError struct {
+	// Err is the error of the transfer call
+	Err error
+	// SaveErr is the error of the Store
+	SaveErr error
+}
+
+func (e *SaveError) Error() string {
+	return fmt.Sprintf("%v (saving the payout failed: %v)", e.Err, e.SaveErr)
+}
+
+func (e *SaveError) Unwrap() error {
+	return e.Err
+}
+
+func (e *SaveError) Is(target error) bool {
+	return errors.Is(e.SaveErr, target)
+}
+
+func (e *SaveError) As(target interface{}) bool {
+	return errors.As(e.SaveErr, target)
+}
+
+// Orchestrator initiates transfers and tracks them until they settle.
+// Transfers that need an OTP are handed to OnOTP, and completed later with Finalize, possibly
+// by another process sharing the same Store.
+type Orchestrator struct {
+	Transfers transfer.Service
+	Store     Store
+	// OnOTP is called when a payout starts waiting for an OTP, e.g. to ask treasury for approval
+	OnOTP func(ctx context.Context, p *Payout)
+	// MaxOTPResends limits the ResendOTP calls per payout
+	MaxOTPResends int
+
+	now func() time.Time
+}
+
+// NewOrchestrator creates an Orchestrator making transfers with transfers and recording them in store
+func NewOrchestrator(transfers transfer.Service, store Store) *Orchestrator {
+	return &Orchestrator{
+		Transfers:     transfers,
+		Store:         store,
+		MaxOTPResends: DefaultMaxOTPResends,
+		now:           time.Now,
+	}
+}
+
+// Initiate records and initiates a transfer. A reference is generated when req has none.
+// The payout is saved before Paystack is called, so a transfer whose call times out, or fails
+// with a server error or a rate limit, keeps an empty status until Reconcile finds it.
+// When Paystack answers with an error, the transfer is verified by reference first: a retry
+// whose first attempt created the transfer is answered with a duplicate reference error.
+func (o *Orchestrator) Initiate(ctx context.Context, req transfer.Request) (*Payout, error) {
+	if req.Reference == "" {
+		req.Reference = o.newReference()
+	}
+
+	now := o.now()
+	p := &Payout{Reference: req.Reference, Request: req, CreatedAt: now, UpdatedAt: now}
+	if err := o.Store.Save(ctx, p); err != nil {
+		return nil, err
+	}
+
+	t, err := o.Transfers.Initiate(ctx, &req)
+	if err == nil {
+		return p, o.update(ctx, p, t)
+	}
+
+	var apiErr *response.APIError
+	if !errors.As(err, &apiErr) {
+		return p, err
+	}
+
+	t, verifyErr := o.Transfers.Verify(ctx, req.Reference)
+	if verifyErr == nil {
+		return p, o.update(ctx, p, t)
+	}
+
+	var notFound *response.APIError
+	rejected := apiErr.HTTPStatusCode == http.StatusBadRequest || apiErr.HTTPStatusCode == http.StatusUnprocessableEntity
+	if rejected && errors.As(verifyErr, &notFound) && notFound.HTTPStatusCode == http.StatusNotFound {
+		// Paystack rejected the request and has no transfer with the reference
+		p.Status = transfer.StatusFailed
+		p.Error = apiErr.Details.Message
+		p.UpdatedAt = o.now()
+		if saveErr := o.Store.Save(ctx, p); saveErr != nil {
+			return p, &SaveError{Err: err, SaveErr: saveErr}
+		}
+	}
+	return p, err
+}
+
+// PendingOTP returns the payouts waiting for an OTP, oldest first
+func (o *Orchestrator) PendingOTP(ctx context.Context) ([]*Payout, error) {
+	return o.Store.List(ctx, transfer.StatusOTP)
+}
+
+// Finalize completes the payout with the given reference using the OTP sent to the business
+func (o *Orchestrator) Finalize(ctx context.Context, reference, otp string) (*Payout, error) {
+	p, err := o.awaitingOTP(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := o.Transfers.Finalize(ctx, p.TransferCode, otp)
+	if err != nil {
+		return p, err
+	}
+
+	status, _ := resp["status"].(string)
+	if status == "" {
+		return o.Reconcile(ctx, reference)
+	}
+	p.Status = transfer.Status(status)
+	p.UpdatedAt = o.now()
+	return p, o.Store.Save(ctx, p)
+}
+
+// ResendOTP sends a new OTP for the payout with the given reference, up to MaxOTPResends times
+func (o *Orchestrator) ResendOTP(ctx context.Context, reference string) (*Payout, error) {
+	p, err := o.awaitingOTP(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.OTPResends >= o.MaxOTPResends {
+		return p, fmt.Errorf("%w: %s", ErrResendLimit, reference)
+	}
+
+	if _, err := o.Transfers.ResendOTP(ctx, p.TransferCode, "transfer"); err != nil {
+		return p, err
+	}
+
+	p.OTPResends++
+	p.UpdatedAt = o.now()
+	return p, o.Store.Save(ctx, p)
+}
+
+// Reconcile refreshes the payout with the given reference from Paystack.
+// Transfers are looked up by transfer code, or verified by reference when the code is not known yet.
+func (o *Orchestrator) Reconcile(ctx context.Context, reference string) (*Payout, error) {
+	p, err := o.Store.Get(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	var t *transfer.Transfer
+	if p.TransferCode != "" {
+		t, err = o.Transfers.Get(ctx, p.TransferCode)
+	} else {
+		t, err = o.Transfers.Verify(ctx, p.Reference)
+	}
+
+	var apiErr *response.APIError
+	if errors.As(err, &apiErr) && apiErr.HTTPStatusCode == http.StatusNotFound && p.TransferCode == "" {
+		// the initiate call never reached Paystack
+		p.Status = transfer.StatusFailed
+		p.Error = "transfer not found"
+		p.UpdatedAt = o.now()
+		return p, o.Store.Save(ctx, p)
+	}
+	if err != nil {
+		return p, err
+	}
+
+	return p, o.update(ctx, p, t)
+}
+
+// ReconcileAll reconciles every payout that has not settled.
+// It carries on past failures and returns the first error.
+func (o *Orchestrator) ReconcileAll(ctx context.Context) error {
+	payouts, err := o.Store.List(ctx, "", transfer.StatusPending, transfer.StatusOTP, transfer.StatusReceived)
+	if err != nil {
+		return err
+	}
+
+	var first error
+	for _, p := range payouts {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if _, err := o.Reconcile(ctx, p.Reference); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// newReference uses the reference generator of the transfer service when it has one
+func (o *Orchestrator) newReference() string {
+	if s, ok := o.Transfers.(*transfer.DefaultTransferService); ok && s.ReferenceGenerator != nil {
+		return s.ReferenceGenerator()
+	}
+	return transfer.NewReference()
+}
+
+func (o *Orchestrator) awaitingOTP(ctx context.Context, reference string) (*Payout, error) {
+	p, err := o.Store.Get(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+	if !p.AwaitingOTP() {
+		return nil, fmt.Errorf("%w: %s is %q", ErrNotAwaitingOTP, reference, p.Status)
+	}
+	return p, nil
+}
+
+// update applies the state of t to p, saves it and hands it to OnOTP if it now needs an OTP
+func (o *Orchestrator) update(ctx context.Context, p *Payout, t *transfer.Transfer) error {
+	wasAwaitingOTP := p.AwaitingOTP()
+
+	if t.TransferCode != "" {
+		p.TransferCode = t.TransferCode
+	}
+	p.Status = t.Status
+	p.UpdatedAt = o.now()
+	if err := o.Store.Save(ctx, p); err != nil {
+		return err
+	}
+
+	if p.AwaitingOTP() && !wasAwaitingOTP && o.OnOTP != nil {
+		o.OnOTP(ctx, p)
+	}
+	return nil
+}
@@ -0,0 +1,321 @@
+package payout
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/hub1989/paystack-api-wrapper/configuration"
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"github.com/hub1989/paystack-api-wrapper/transfer"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+type fakeTransfers struct {
+	transfer.Service
+
+	initiateErr error
+	transfers   map[string]*transfer.Transfer
+	resends     int
+}
+
+func newFakeTransfers() *fakeTransfers {
+	return &fakeTransfers{transfers: map[string]*transfer.Transfer{}}
+}
+
+func (f *fakeTransfers) Initiate(ctx context.Context, req *transfer.Request) (*transfer.Transfer, error) {
+	if f.initiateErr != nil {
+		return nil, f.initiateErr
+	}
+	t := &transfer.Transfer{Reference: req.Reference, TransferCode: "TRF_" + req.Reference, Status: transfer.StatusOTP}
+	f.transfers[t.TransferCode] = t
+	return t, nil
+}
+
+func (f *fakeTransfers) Finalize(ctx context.Context, code, otp string) (response.Response, error) {
+	if otp != "123456" {
+		return nil, &response.APIError{HTTPStatusCode: http.StatusBadRequest}
+	}
+	f.transfers[code].Status = transfer.StatusSuccess
+	return response.Response{"transfer_code": code, "status": "success"}, nil
+}
+
+func (f *fakeTransfers) ResendOTP(ctx context.Context, transferCode, reason string) (response.Response, error) {
+	f.resends++
+	return response.Response{}, nil
+}
+
+func (f *fakeTransfers) Get(ctx context.Context, idCode string) (*transfer.Transfer, error) {
+	t, ok := f.transfers[idCode]
+	if !ok {
+		return nil, &response.APIError{HTTPStatusCode: http.StatusNotFound}
+	}
+	return t, nil
+}
+
+func (f *fakeTransfers) Verify(ctx context.Context, reference string) (*transfer.Transfer, error) {
+	for _, t := range f.transfers {
+		if t.Reference == reference {
+			return t, nil
+		}
+	}
+	return nil, &response.APIError{HTTPStatusCode: http.StatusNotFound}
+}
+
+func TestPayoutOTPHandoff(t *testing.T) {
+	fake := newFakeTransfers()
+	o := NewOrchestrator(fake, NewMemoryStore())
+	o.MaxOTPResends = 1
+
+	var approvals []string
+	o.OnOTP = func(ctx context.Context, p *Payout) {
+		approvals = append(approvals, p.Reference)
+	}
+
+	p, err := o.Initiate(context.TODO(), transfer.Request{Source: "balance", Amount: 5000, Recipient: "RCP_1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Reference == "" || p.TransferCode != "TRF_"+p.Reference || !p.AwaitingOTP() {
+		t.Fatalf("Expected a payout awaiting otp, got %+v", p)
+	}
+
+	if len(approvals) != 1 || approvals[0] != p.Reference {
+		t.Errorf("Expected OnOTP to be called once for %s, got %v", p.Reference, approvals)
+	}
+
+	pending, err := o.PendingOTP(context.TODO())
+	if err != nil || len(pending) != 1 {
+		t.Fatalf("Expected 1 payout pending otp, got %d (%v)", len(pending), err)
+	}
+
+	if _, err := o.ResendOTP(context.TODO(), p.Reference); err != nil {
+		t.Error(err)
+	}
+	if _, err := o.ResendOTP(context.TODO(), p.Reference); !errors.Is(err, ErrResendLimit) {
+		t.Errorf("Expected ErrResendLimit, got %v", err)
+	}
+	if fake.resends != 1 {
+		t.Errorf("Expected 1 otp resend, got %d", fake.resends)
+	}
+
+	if _, err := o.Finalize(context.TODO(), p.Reference, "000000"); err == nil {
+		t.Error("Expected a wrong otp to fail")
+	}
+
+	p, err = o.Finalize(context.TODO(), p.Reference, "123456")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Status != transfer.StatusSuccess || !p.Settled() {
+		t.Errorf("Expected a successful payout, got %+v", p)
+	}
+
+	if _, err := o.Finalize(context.TODO(), p.Reference, "123456"); !errors.Is(err, ErrNotAwaitingOTP) {
+		t.Errorf("Expected ErrNotAwaitingOTP, got %v", err)
+	}
+}
+
+func TestPayoutInitiateRejected(t *testing.T) {
+	fake := newFakeTransfers()
+	fake.initiateErr = &response.APIError{
+		HTTPStatusCode: http.StatusBadRequest,
+		Details:        response.ErrorResponse{Message: "Insufficient balance"},
+	}
+	o := NewOrchestrator(fake, NewMemoryStore())
+
+	p, err := o.Initiate(context.TODO(), transfer.Request{Reference: "payout_rejected_01", Amount: 5000, Recipient: "RCP_1"})
+	if err == nil {
+		t.Fatal("Expected initiate to fail")
+	}
+
+	if p.Status != transfer.StatusFailed || p.Error != "Insufficient balance" {
+		t.Errorf("Expected a failed payout, got %+v", p)
+	}
+}
+
+func TestPayoutInitiateServerError(t *testing.T) {
+	fake := newFakeTransfers()
+	fake.initiateErr = &response.APIError{
+		HTTPStatusCode: http.StatusBadGateway,
+		Details:        response.ErrorResponse{Message: "Bad gateway"},
+	}
+	store := NewMemoryStore()
+	o := NewOrchestrator(fake, store)
+
+	p, err := o.Initiate(context.TODO(), transfer.Request{Reference: "payout_gateway_01", Amount: 5000, Recipient: "RCP_1"})
+	if err == nil {
+		t.Fatal("Expected initiate to fail")
+	}
+
+	if p.Status != "" {
+		t.Errorf("Expected an unconfirmed payout after a server error, got %q", p.Status)
+	}
+
+	// the transfer was created despite the error
+	fake.transfers["TRF_gateway"] = &transfer.Transfer{Reference: p.Reference, TransferCode: "TRF_gateway", Status: transfer.StatusSuccess}
+	if err := o.ReconcileAll(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+
+	p, _ = store.Get(context.TODO(), "payout_gateway_01")
+	if p.Status != transfer.StatusSuccess {
+		t.Errorf("Expected reconcile to find the transfer, got %+v", p)
+	}
+}
+
+func TestPayoutInitiateRateLimited(t *testing.T) {
+	fake := newFakeTransfers()
+	fake.initiateErr = &response.APIError{HTTPStatusCode: http.StatusTooManyRequests}
+	o := NewOrchestrator(fake, NewMemoryStore())
+
+	p, err := o.Initiate(context.TODO(), transfer.Request{Reference: "payout_limited_01", Amount: 5000, Recipient: "RCP_1"})
+	if err == nil {
+		t.Fatal("Expected initiate to fail")
+	}
+
+	if p.Status != "" {
+		t.Errorf("Expected a rate limited payout not to be failed, got %q", p.Status)
+	}
+}
+
+func TestPayoutInitiateDuplicateReference(t *testing.T) {
+	fake := newFakeTransfers()
+	o := NewOrchestrator(fake, NewMemoryStore())
+
+	// the first attempt created the transfer, the retry is refused as a duplicate
+	fake.transfers["TRF_retried"] = &transfer.Transfer{Reference: "payout_retried_01", TransferCode: "TRF_retried", Status: transfer.StatusPending}
+	fake.initiateErr = &response.APIError{
+		HTTPStatusCode: http.StatusBadRequest,
+		Details:        response.ErrorResponse{Message: "Duplicate Transfer Reference"},
+	}
+
+	p, err := o.Initiate(context.TODO(), transfer.Request{Reference: "payout_retried_01", Amount: 5000, Recipient: "RCP_1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Status != transfer.StatusPending || p.TransferCode != "TRF_retried" {
+		t.Errorf("Expected the existing transfer to be tracked, got %+v", p)
+	}
+}
+
+type failingStore struct {
+	*MemoryStore
+	saves   int
+	failAt  int
+	saveErr error
+}
+
+func (f *failingStore) Save(ctx context.Context, p *Payout) error {
+	f.saves++
+	if f.saves == f.failAt {
+		return f.saveErr
+	}
+	return f.MemoryStore.Save(ctx, p)
+}
+
+func TestPayoutInitiateRejectedSaveFails(t *testing.T) {
+	fake := newFakeTransfers()
+	apiErr := &response.APIError{HTTPStatusCode: http.StatusBadRequest, Details: response.ErrorResponse{Message: "Invalid recipient"}}
+	fake.initiateErr = apiErr
+	saveErr := errors.New("database unavailable")
+	o := NewOrchestrator(fake, &failingStore{MemoryStore: NewMemoryStore(), failAt: 2, saveErr: saveErr})
+
+	_, err := o.Initiate(context.TODO(), transfer.Request{Reference: "payout_unsaved_01", Amount: 5000, Recipient: "RCP_1"})
+
+	var got *response.APIError
+	if !errors.Is(err, saveErr) || !errors.As(err, &got) || got != apiErr {
+		t.Errorf("Expected both the API and the store error, got %v", err)
+	}
+}
+
+func TestPayoutOTPWireFormat(t *testing.T) {
+	bodies := map[string]map[string]interface{}{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]interface{}{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		bodies[r.URL.Path] = body
+
+		data := map[string]interface{}{"transfer_code": "TRF_wire", "reference": body["reference"], "status": "otp"}
+		if r.URL.Path == "/transfer/finalize_transfer" {
+			data["status"] = "success"
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": true, "data": data})
+	}))
+	t.Cleanup(server.Close)
+
+	c := configuration.NewClient("sk_test", server.Client(), false)
+	c.BaseURL, _ = url.Parse(server.URL)
+	transfers := &transfer.DefaultTransferService{Client: c, ReferenceGenerator: func() string { return "payout_generated_1" }}
+	o := NewOrchestrator(transfers, NewMemoryStore())
+
+	p, err := o.Initiate(context.TODO(), transfer.Request{Source: "balance", Amount: 5000, Recipient: "RCP_1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Reference != "payout_generated_1" || bodies["/transfer"]["reference"] != "payout_generated_1" {
+		t.Errorf("Expected the service's reference generator to be used, got %q", p.Reference)
+	}
+
+	if _, err := o.ResendOTP(context.TODO(), p.Reference); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := o.Finalize(context.TODO(), p.Reference, "123456"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]map[string]interface{}{
+		"/transfer/resend_otp":        {"transfer_code": "TRF_wire", "reason": "transfer"},
+		"/transfer/finalize_transfer": {"transfer_code": "TRF_wire", "otp": "123456"},
+	}
+	for path, want := range expected {
+		for key, value := range want {
+			if bodies[path][key] != value {
+				t.Errorf("Expected %s to send %s=%q, got %#v", path, key, value, bodies[path][key])
+			}
+		}
+	}
+}
+
+func TestPayoutReconcileAfterTimeout(t *testing.T) {
+	fake := newFakeTransfers()
+	store := NewMemoryStore()
+	o := NewOrchestrator(fake, store)
+
+	// the transfer is created, but the response never arrives
+	fake.initiateErr = context.DeadlineExceeded
+	p, err := o.Initiate(context.TODO(), transfer.Request{Reference: "payout_timeout_001", Amount: 5000, Recipient: "RCP_1"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected a timeout, got %v", err)
+	}
+	fake.transfers["TRF_late"] = &transfer.Transfer{Reference: p.Reference, TransferCode: "TRF_late", Status: transfer.StatusPending}
+
+	// this one never reached Paystack
+	if _, err := o.Initiate(context.TODO(), transfer.Request{Reference: "payout_lost_000001", Amount: 5000, Recipient: "RCP_1"}); err == nil {
+		t.Fatal("Expected a timeout")
+	}
+
+	if p.Status != "" {
+		t.Errorf("Expected an unconfirmed payout, got %q", p.Status)
+	}
+
+	if err := o.ReconcileAll(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+
+	p, _ = store.Get(context.TODO(), "payout_timeout_001")
+	if p.TransferCode != "TRF_late" || p.Status != transfer.StatusPending {
+		t.Errorf("Expected the reconciled transfer, got %+v", p)
+	}
+
+	lost, _ := store.Get(context.TODO(), "payout_lost_000001")
+	if lost.Status != transfer.StatusFailed {
+		t.Errorf("Expected the lost payout to fail, got %+v", lost)
+	}
+}
@@ -0,0 +1,82 @@
+package payout
+
+import (
+	"context"
+	"errors"
+	"github.com/hub1989/paystack-api-wrapper/transfer"
+	"sort"
+	"sync"
+)
+
+// ErrNotFound is returned by a Store when no payout has the given reference
+var ErrNotFound = errors.New("payout: not found")
+
+// Store persists payouts. Implementations must be safe for concurrent use.
+type Store interface {
+	// Save creates or replaces the payout with the same reference
+	Save(ctx context.Context, p *Payout) error
+	// Get returns the payout with the given reference, or ErrNotFound
+	Get(ctx context.Context, reference string) (*Payout, error)
+	// List returns the payouts with one of the given statuses, or every payout when none are given
+	List(ctx context.Context, statuses ...transfer.Status) ([]*Payout, error)
+}
+
+// MemoryStore is a Store keeping payouts in memory, for tests and single process deployments
+type MemoryStore struct {
+	mu      sync.RWMutex
+	payouts map[string]Payout
+}
+
+// NewMemoryStore creates an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{payouts: map[string]Payout{}}
+}
+
+func (m *MemoryStore) Save(ctx context.Context, p *Payout) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.payouts[p.Reference] = *p
+	return nil
+}
+
+func (m *MemoryStore) Get(ctx context.Context, reference string) (*Payout, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	p, ok := m.payouts[reference]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &p, nil
+}
+
+func (m *MemoryStore) List(ctx context.Context, statuses ...transfer.Status) ([]*Payout, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var payouts []*Payout
+	for _, p := range m.payouts {
+		if !hasStatus(p.Status, statuses) {
+			continue
+		}
+		p := p
+		payouts = append(payouts, &p)
+	}
+
+	sort.Slice(payouts, func(i, j int) bool {
+		return payouts[i].CreatedAt.Before(payouts[j].CreatedAt)
+	})
+	return payouts, nil
+}
+
+func hasStatus(status transfer.Status, statuses []transfer.Status) bool {
+	if len(statuses) == 0 {
+		return true
+	}
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"github.com/hub1989/paystack-api-wrapper/client"
 	"github.com/hub1989/paystack-api-wrapper/configuration"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 )
 
@@ -15,11 +18,11 @@ func init() {
 }
 
 //func TestResolveCardBIN(t *testing.T) {
-//	resp, err := C.ResolveCardBIN(59983)
+//	resp, err := C.ResolveCardBIN(context.TODO(), "539983")
 //	if err != nil {
 //		t.Error(err)
 //	}
-//	if _, ok := resp["bin"]; !ok {
+//	if resp.BIN == "" {
 //		t.Errorf("Expected response to contain bin")
 //	}
 //}
@@ -38,6 +41,29 @@ func TestCheckBalance(t *testing.T) {
 	}
 }
 
+func TestCheckBalanceWithoutBalances(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status": true, "message": "Balances retrieved", "data": []}`))
+	}))
+	defer server.Close()
+
+	c := configuration.NewClient("sk_test", server.Client(), false)
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	resp, err := c.CheckBalance(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 0 {
+		t.Errorf("Expected an empty balance, got %v", resp)
+	}
+
+	server.Close()
+	if _, err := c.CheckBalance(context.TODO()); err == nil {
+		t.Error("Expected an error once the server is gone")
+	}
+}
+
 func TestSessionTimeout(t *testing.T) {
 	resp, err := C.GetSessionTimeout(context.TODO())
 	if err != nil {
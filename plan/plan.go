@@ -2,18 +2,24 @@ package plan
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hub1989/paystack-api-wrapper/client"
-	"github.com/hub1989/paystack-api-wrapper/response"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
+// ErrNoIDOrCode is returned by Update for a plan with neither an ID nor a plan code
+var ErrNoIDOrCode = errors.New("plan: id or plan code is required")
+
 type Service interface {
 	Create(ctx context.Context, plan *Plan) (*Plan, error)
-	Update(ctx context.Context, plan *Plan) (response.Response, error)
-	Get(ctx context.Context, id int) (*Plan, error)
+	Update(ctx context.Context, plan *Plan, updateExistingSubscriptions bool) (*Plan, error)
+	Get(ctx context.Context, idCode string) (*Plan, error)
 	List(ctx context.Context) (*List, error)
 	ListN(ctx context.Context, count, offset int) (*List, error)
+	ListWithOptions(ctx context.Context, opts *ListOptions) (*List, error)
 }
 
 // DefaultPlanService handles operations related to the plan
@@ -22,28 +28,57 @@ type DefaultPlanService struct {
 	*client.Client
 }
 
-// Create creates a new plan
+// Create validates and creates a new plan
 // For more details see https://developers.paystack.co/v1.0/reference#create-plan
 func (s *DefaultPlanService) Create(ctx context.Context, plan *Plan) (*Plan, error) {
+	if err := plan.Validate(); err != nil {
+		return nil, err
+	}
+
 	u := fmt.Sprintf("/plan")
 	plan2 := &Plan{}
 	err := s.Client.Call(ctx, http.MethodPost, u, plan, plan2)
 	return plan2, err
 }
 
-// Update updates a plan's properties.
+// Update validates and updates a plan's properties. The plan is identified by its ID, or its plan code when the ID is not set.
+// Set updateExistingSubscriptions to apply the new amount and interval to current subscribers too.
+// Paystack answers the update with a message only, so the updated plan is fetched and returned.
 // For more details see https://developers.paystack.co/v1.0/reference#update-plan
-func (s *DefaultPlanService) Update(ctx context.Context, plan *Plan) (response.Response, error) {
-	u := fmt.Sprintf("plan/%d", plan.ID)
-	resp := response.Response{}
-	err := s.Client.Call(ctx, http.MethodPut, u, plan, &resp)
-	return resp, err
+func (s *DefaultPlanService) Update(ctx context.Context, plan *Plan, updateExistingSubscriptions bool) (*Plan, error) {
+	idCode := plan.PlanCode
+	if plan.ID != 0 {
+		idCode = strconv.Itoa(plan.ID)
+	}
+	if idCode == "" {
+		return nil, ErrNoIDOrCode
+	}
+	if err := plan.Validate(); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("/plan/%s", idCode)
+	reqBody := struct {
+		*Plan
+		UpdateExistingSubscriptions bool `json:"update_existing_subscriptions"`
+	}{
+		Plan:                        plan,
+		UpdateExistingSubscriptions: updateExistingSubscriptions,
+	}
+	updated := &Plan{}
+	if err := s.Client.Call(ctx, http.MethodPut, u, reqBody, updated); err != nil {
+		return updated, err
+	}
+	if updated.ID != 0 || updated.PlanCode != "" {
+		return updated, nil
+	}
+	return s.Get(ctx, idCode)
 }
 
-// Get returns the details of a plan.
+// Get returns the details of a plan, given its ID or plan code.
 // For more details see https://developers.paystack.co/v1.0/reference#fetch-plan
-func (s *DefaultPlanService) Get(ctx context.Context, id int) (*Plan, error) {
-	u := fmt.Sprintf("/plan/%d", id)
+func (s *DefaultPlanService) Get(ctx context.Context, idCode string) (*Plan, error) {
+	u := fmt.Sprintf("/plan/%s", idCode)
 	plan2 := &Plan{}
 	err := s.Client.Call(ctx, http.MethodGet, u, nil, plan2)
 	return plan2, err
@@ -63,3 +98,26 @@ func (s *DefaultPlanService) ListN(ctx context.Context, count, offset int) (*Lis
 	err := s.Client.Call(ctx, http.MethodGet, u, nil, plan2)
 	return plan2, err
 }
+
+// ListWithOptions returns the plans matching opts
+// For more details see https://paystack.com/docs/api/plan/#list
+func (s *DefaultPlanService) ListWithOptions(ctx context.Context, opts *ListOptions) (*List, error) {
+	params := url.Values{}
+	if opts != nil {
+		params.Set("status", opts.Status)
+		params.Set("interval", string(opts.Interval))
+		if opts.Amount > 0 {
+			params.Set("amount", strconv.FormatFloat(float64(opts.Amount), 'f', -1, 32))
+		}
+		if opts.PerPage > 0 {
+			params.Set("perPage", strconv.Itoa(opts.PerPage))
+		}
+		if opts.Page > 0 {
+			params.Set("page", strconv.Itoa(opts.Page))
+		}
+	}
+
+	plan2 := &List{}
+	err := s.Client.Call(ctx, http.MethodGet, client.AddQuery("/plan", params), nil, plan2)
+	return plan2, err
+}
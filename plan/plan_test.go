@@ -2,8 +2,14 @@ package plan
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"github.com/hub1989/paystack-api-wrapper/client"
 	"github.com/hub1989/paystack-api-wrapper/configuration"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
 	"testing"
 )
 
@@ -19,7 +25,7 @@ func init() {
 func TestPlanCRUD(t *testing.T) {
 	plan1 := &Plan{
 		Name:     "Monthly retainer",
-		Interval: "monthly",
+		Interval: IntervalMonthly,
 		Amount:   500000,
 	}
 
@@ -34,7 +40,7 @@ func TestPlanCRUD(t *testing.T) {
 	}
 
 	// retrieve the plan
-	plan, err = service.Get(context.TODO(), plan.ID)
+	plan, err = service.Get(context.TODO(), strconv.Itoa(plan.ID))
 	if err != nil {
 		t.Errorf("GET Plan returned error: %v", err)
 	}
@@ -43,9 +49,109 @@ func TestPlanCRUD(t *testing.T) {
 		t.Errorf("Expected Plan Name %v, got %v", plan.Name, plan1.Name)
 	}
 
+	// retrieve the plan by code
+	byCode, err := service.Get(context.TODO(), plan.PlanCode)
+	if err != nil || byCode.ID != plan.ID {
+		t.Errorf("GET Plan by code returned %+v, error: %v", byCode, err)
+	}
+
+	// update the plan and its subscriptions
+	plan.Amount = 600000
+	updated, err := service.Update(context.TODO(), plan, true)
+	if err != nil || updated.Amount != 600000 {
+		t.Errorf("UPDATE Plan returned %+v, error: %v", updated, err)
+	}
+
+	// filter the plan list
+	monthly, err := service.ListWithOptions(context.TODO(), &ListOptions{Interval: IntervalMonthly, Amount: 600000})
+	if err != nil {
+		t.Errorf("LIST Plans returned error: %v", err)
+	}
+	for _, p := range monthly.Values {
+		if p.Interval != IntervalMonthly {
+			t.Errorf("Expected only monthly plans, got %+v", p)
+		}
+	}
+
 	// retrieve the plan list
 	plans, err := service.List(context.TODO())
 	if err != nil || !(len(plans.Values) > 0) || !(plans.Meta.Total > 0) {
 		t.Errorf("Expected Plan list, got %d, returned error %v", len(plans.Values), err)
 	}
 }
+
+func TestPlanValidate(t *testing.T) {
+	valid := &Plan{Name: "Weekly box", Interval: IntervalWeekly, Amount: 100000, Currency: "NGN"}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Expected plan to be valid, got %v", err)
+	}
+
+	invalid := []*Plan{
+		{Interval: IntervalWeekly, Amount: 100000},
+		{Name: "Weekly box", Interval: IntervalWeekly},
+		{Name: "Weekly box", Interval: "fortnightly", Amount: 100000},
+		{Name: "Weekly box", Interval: IntervalWeekly, Amount: 100000, Currency: "EUR"},
+	}
+	for _, p := range invalid {
+		if err := p.Validate(); !errors.Is(err, ErrInvalidPlan) {
+			t.Errorf("Expected plan %+v to be invalid, got %v", p, err)
+		}
+	}
+
+	if _, err := service.Create(context.TODO(), invalid[0]); !errors.Is(err, ErrInvalidPlan) {
+		t.Errorf("Expected Create to reject an invalid plan, got %v", err)
+	}
+}
+
+func TestPlanUpdate(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodPut {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": true, "message": "Plan updated. 1 subscription(s) affected"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": true,
+			"data":   map[string]interface{}{"id": 28, "plan_code": "PLN_gx2wn530m0i3w3m", "name": "Weekly box", "amount": 150000, "interval": "weekly"},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	offline := configuration.NewClient("sk_test", server.Client(), false)
+	offline.BaseURL, _ = url.Parse(server.URL)
+	plans := &DefaultPlanService{Client: offline}
+
+	updated, err := plans.Update(context.TODO(), &Plan{ID: 28, PlanCode: "PLN_gx2wn530m0i3w3m", Name: "Weekly box", Interval: IntervalWeekly, Amount: 150000}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Amount != 150000 || updated.PlanCode != "PLN_gx2wn530m0i3w3m" {
+		t.Errorf("Expected the updated plan, got %+v", updated)
+	}
+
+	if _, err := plans.Update(context.TODO(), &Plan{PlanCode: "PLN_gx2wn530m0i3w3m", Name: "Weekly box", Interval: IntervalWeekly, Amount: 150000}, false); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"PUT /plan/28", "GET /plan/28", "PUT /plan/PLN_gx2wn530m0i3w3m", "GET /plan/PLN_gx2wn530m0i3w3m"}
+	if len(requests) != len(want) {
+		t.Fatalf("Expected requests %v, got %v", want, requests)
+	}
+	for i := range want {
+		if requests[i] != want[i] {
+			t.Errorf("Expected requests %v, got %v", want, requests)
+			break
+		}
+	}
+
+	if _, err := plans.Update(context.TODO(), &Plan{Name: "Weekly box", Interval: IntervalWeekly, Amount: 150000}, false); !errors.Is(err, ErrNoIDOrCode) {
+		t.Errorf("Expected ErrNoIDOrCode, got %v", err)
+	}
+	if _, err := plans.Update(context.TODO(), &Plan{ID: 28, Name: "Weekly box", Interval: "fortnightly", Amount: 150000}, false); !errors.Is(err, ErrInvalidPlan) {
+		t.Errorf("Expected ErrInvalidPlan, got %v", err)
+	}
+	if len(requests) != len(want) {
+		t.Errorf("Expected rejected updates not to be sent, got %v", requests)
+	}
+}
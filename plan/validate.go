@@ -0,0 +1,51 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidPlan is returned when a plan fails validation before it is sent to Paystack
+var ErrInvalidPlan = errors.New("plan: invalid plan")
+
+// Currencies lists the currencies plans can be billed in
+var Currencies = []string{"NGN", "GHS", "ZAR", "USD", "KES"}
+
+// Validate checks the plan name, amount, interval and currency.
+// The currency may be left empty, in which case Paystack uses the integration's default.
+func (p *Plan) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidPlan)
+	}
+	if p.Amount <= 0 {
+		return fmt.Errorf("%w: amount must be positive, got %v", ErrInvalidPlan, p.Amount)
+	}
+	if !p.Interval.Valid() {
+		return fmt.Errorf("%w: unknown interval %q", ErrInvalidPlan, p.Interval)
+	}
+	if p.Currency != "" && !validCurrency(p.Currency) {
+		return fmt.Errorf("%w: unsupported currency %q", ErrInvalidPlan, p.Currency)
+	}
+	if p.InvoiceLimit < 0 {
+		return fmt.Errorf("%w: invoice limit cannot be negative", ErrInvalidPlan)
+	}
+	return nil
+}
+
+// Valid reports whether i is an interval Paystack supports
+func (i Interval) Valid() bool {
+	switch i {
+	case IntervalHourly, IntervalDaily, IntervalWeekly, IntervalMonthly, IntervalQuarterly, IntervalBiannually, IntervalAnnually:
+		return true
+	}
+	return false
+}
+
+func validCurrency(currency string) bool {
+	for _, c := range Currencies {
+		if c == currency {
+			return true
+		}
+	}
+	return false
+}
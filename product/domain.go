@@ -0,0 +1,35 @@
+package product
+
+import (
+	"github.com/hub1989/paystack-api-wrapper/client"
+	"github.com/hub1989/paystack-api-wrapper/response"
+)
+
+// Product represents a Paystack product.
+// Quantity and Unlimited are pointers so a quantity of 0 or unlimited false can be sent.
+// For more details see https://paystack.com/docs/api/product/
+type Product struct {
+	ID           int             `json:"id,omitempty"`
+	CreatedAt    string          `json:"createdAt,omitempty"`
+	UpdatedAt    string          `json:"updatedAt,omitempty"`
+	Domain       string          `json:"domain,omitempty"`
+	Integration  int             `json:"integration,omitempty"`
+	Name         string          `json:"name,omitempty"`
+	Description  string          `json:"description,omitempty"`
+	ProductCode  string          `json:"product_code,omitempty"`
+	Slug         string          `json:"slug,omitempty"`
+	Price        float32         `json:"price,omitempty"`
+	Currency     string          `json:"currency,omitempty"`
+	Quantity     *int            `json:"quantity,omitempty"`
+	QuantitySold int             `json:"quantity_sold,omitempty"`
+	Unlimited    *bool           `json:"unlimited,omitempty"`
+	InStock      bool            `json:"in_stock,omitempty"`
+	Active       bool            `json:"active,omitempty"`
+	Metadata     client.Metadata `json:"metadata,omitempty"`
+}
+
+// List is a list object for products.
+type List struct {
+	Meta   response.ListMeta
+	Values []Product `json:"data,omitempty"`
+}
@@ -0,0 +1,64 @@
+package product
+
+import (
+	"context"
+	"fmt"
+	"github.com/hub1989/paystack-api-wrapper/client"
+	"net/http"
+)
+
+type Service interface {
+	Create(ctx context.Context, product *Product) (*Product, error)
+	Update(ctx context.Context, product *Product) (*Product, error)
+	Get(ctx context.Context, id int) (*Product, error)
+	List(ctx context.Context) (*List, error)
+	ListN(ctx context.Context, count, offset int) (*List, error)
+}
+
+// DefaultProductService handles operations related to products
+// For more details see https://paystack.com/docs/api/product/
+type DefaultProductService struct {
+	*client.Client
+}
+
+// Create creates a new product.
+// Set Unlimited for products without stock tracking, otherwise Quantity is the stock available.
+// For more details see https://paystack.com/docs/api/product/#create
+func (s *DefaultProductService) Create(ctx context.Context, product *Product) (*Product, error) {
+	prod := &Product{}
+	err := s.Client.Call(ctx, http.MethodPost, "/product", product, prod)
+	return prod, err
+}
+
+// Update updates a product's properties.
+// For more details see https://paystack.com/docs/api/product/#update
+func (s *DefaultProductService) Update(ctx context.Context, product *Product) (*Product, error) {
+	u := fmt.Sprintf("/product/%d", product.ID)
+	prod := &Product{}
+	err := s.Client.Call(ctx, http.MethodPut, u, product, prod)
+	return prod, err
+}
+
+// Get returns the details of a product.
+// For more details see https://paystack.com/docs/api/product/#fetch
+func (s *DefaultProductService) Get(ctx context.Context, id int) (*Product, error) {
+	u := fmt.Sprintf("/product/%d", id)
+	prod := &Product{}
+	err := s.Client.Call(ctx, http.MethodGet, u, nil, prod)
+	return prod, err
+}
+
+// List returns a list of products.
+// For more details see https://paystack.com/docs/api/product/#list
+func (s *DefaultProductService) List(ctx context.Context) (*List, error) {
+	return s.ListN(ctx, 10, 1)
+}
+
+// ListN returns a list of products
+// For more details see https://paystack.com/docs/api/product/#list
+func (s *DefaultProductService) ListN(ctx context.Context, count, offset int) (*List, error) {
+	u := client.PaginateURL("/product", count, offset)
+	prods := &List{}
+	err := s.Client.Call(ctx, http.MethodGet, u, nil, prods)
+	return prods, err
+}
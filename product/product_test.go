@@ -0,0 +1,88 @@
+package product
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hub1989/paystack-api-wrapper/client"
+	"github.com/hub1989/paystack-api-wrapper/configuration"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+var c *client.Client
+var service *DefaultProductService
+
+func init() {
+	apiKey := client.MustGetTestKey()
+	c = configuration.NewClient(apiKey, nil, true)
+	service = &DefaultProductService{Client: c}
+}
+
+func TestProductCRUD(t *testing.T) {
+	quantity := 10
+	product1 := &Product{
+		Name:        "Demo product",
+		Description: "Paystack Go client test product",
+		Price:       500000,
+		Currency:    "NGN",
+		Quantity:    &quantity,
+	}
+
+	// create the product
+	product, err := service.Create(context.TODO(), product1)
+	if err != nil {
+		t.Errorf("CREATE Product returned error: %v", err)
+	}
+
+	// retrieve the product
+	product, err = service.Get(context.TODO(), product.ID)
+	if err != nil {
+		t.Errorf("GET Product returned error: %v", err)
+	}
+
+	if product.Name != product1.Name {
+		t.Errorf("Expected Product Name %v, got %v", product1.Name, product.Name)
+	}
+
+	if product.Quantity == nil || *product.Quantity != *product1.Quantity {
+		t.Errorf("Expected Product Quantity %v, got %v", *product1.Quantity, product.Quantity)
+	}
+
+	// retrieve the product list
+	products, err := service.List(context.TODO())
+	if err != nil || !(len(products.Values) > 0) || !(products.Meta.Total > 0) {
+		t.Errorf("Expected Product list, got %d, returned error %v", len(products.Values), err)
+	}
+}
+
+func TestProductSendsZeroQuantity(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": true,
+			"data":   map[string]interface{}{"id": 1, "quantity": 0, "unlimited": false},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	offline := configuration.NewClient("sk_test", server.Client(), false)
+	offline.BaseURL, _ = url.Parse(server.URL)
+	products := &DefaultProductService{Client: offline}
+
+	quantity, unlimited := 0, false
+	product, err := products.Update(context.TODO(), &Product{ID: 1, Quantity: &quantity, Unlimited: &unlimited})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if body["quantity"] != float64(0) || body["unlimited"] != false {
+		t.Errorf("Expected quantity 0 and unlimited false to be sent, got %v", body)
+	}
+
+	if product.Quantity == nil || *product.Quantity != 0 {
+		t.Errorf("Expected a quantity of 0, got %v", product.Quantity)
+	}
+}
@@ -0,0 +1,70 @@
+package reconcile
+
+import (
+	"time"
+)
+
+// Record is an entry of your own ledger, e.g. an order, to be matched against Paystack by reference.
+// Amounts are in the currency's subunit, e.g. kobo, so they compare exactly.
+type Record interface {
+	Reference() string
+	Amount() int64
+	Currency() string
+	Date() time.Time
+}
+
+// Status is the outcome of reconciling a reference
+type Status string
+
+const (
+	StatusMatched           Status = "matched"
+	StatusMissingOnPaystack Status = "missing_on_paystack"
+	StatusMissingLocally    Status = "missing_locally"
+	StatusAmountMismatch    Status = "amount_mismatch"
+	StatusFeeVariance       Status = "fee_variance"
+)
+
+// Statuses lists every Status in report order
+var Statuses = []Status{StatusMatched, StatusMissingOnPaystack, StatusMissingLocally, StatusAmountMismatch, StatusFeeVariance}
+
+// Item is the reconciliation of a single reference
+type Item struct {
+	Status         Status    `json:"status"`
+	Reference      string    `json:"reference"`
+	Currency       string    `json:"currency"`
+	LocalAmount    int64     `json:"local_amount"`
+	PaystackAmount int64     `json:"paystack_amount"`
+	Fees           int64     `json:"fees"`
+	ExpectedFees   int64     `json:"expected_fees"`
+	SettlementID   int       `json:"settlement_id,omitempty"`
+	Date           time.Time `json:"date"`
+}
+
+// Report is the result of a reconciliation run
+type Report struct {
+	From  time.Time `json:"from"`
+	To    time.Time `json:"to"`
+	Items []Item    `json:"items"`
+}
+
+// Count returns the number of items with the given status
+func (r *Report) Count(status Status) int {
+	n := 0
+	for _, item := range r.Items {
+		if item.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// Filter returns the items with the given status
+func (r *Report) Filter(status Status) []Item {
+	var items []Item
+	for _, item := range r.Items {
+		if item.Status == status {
+			items = append(items, item)
+		}
+	}
+	return items
+}
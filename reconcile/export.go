@@ -0,0 +1,79 @@
+package reconcile
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+)
+
+var csvHeader = []string{"status", "reference", "currency", "local_amount", "paystack_amount", "fees", "expected_fees", "settlement_id", "date"}
+
+// WriteCSV writes the report items to w as CSV, with a header row
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, item := range r.Items {
+		settlementID := ""
+		if item.SettlementID != 0 {
+			settlementID = strconv.Itoa(item.SettlementID)
+		}
+		date := ""
+		if !item.Date.IsZero() {
+			date = item.Date.Format(time.RFC3339)
+		}
+
+		err := cw.Write([]string{
+			string(item.Status),
+			item.Reference,
+			item.Currency,
+			formatAmount(item.LocalAmount),
+			formatAmount(item.PaystackAmount),
+			formatAmount(item.Fees),
+			formatAmount(item.ExpectedFees),
+			settlementID,
+			date,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes the report to w as JSON, with a count of the items of each status
+func (r *Report) WriteJSON(w io.Writer) error {
+	summary := make(map[Status]int, len(Statuses))
+	for _, s := range Statuses {
+		summary[s] = r.Count(s)
+	}
+
+	items := r.Items
+	if items == nil {
+		items = []Item{}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		From    time.Time      `json:"from"`
+		To      time.Time      `json:"to"`
+		Summary map[Status]int `json:"summary"`
+		Items   []Item         `json:"items"`
+	}{
+		From:    r.From,
+		To:      r.To,
+		Summary: summary,
+		Items:   items,
+	})
+}
+
+func formatAmount(amount int64) string {
+	return strconv.FormatInt(amount, 10)
+}
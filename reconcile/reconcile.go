@@ -0,0 +1,218 @@
+package reconcile
+
+import (
+	"context"
+	"github.com/hub1989/paystack-api-wrapper/settlement"
+	"github.com/hub1989/paystack-api-wrapper/transaction"
+	"sort"
+	"time"
+)
+
+// DefaultPageSize is the number of transactions and settlements requested per page
+const DefaultPageSize = 100
+
+// DefaultListMargin is how long before the period transactions paid in it are looked for
+const DefaultListMargin = 7 * 24 * time.Hour
+
+// Reconciler matches your records against the successful Paystack transactions of a period
+// and the settlements that paid them out.
+type Reconciler struct {
+	Transactions transaction.Service
+	Settlements  settlement.Service
+
+	// ExpectedFee returns the fee you expect Paystack to charge on a transaction.
+	// Fees are not checked when it is nil.
+	ExpectedFee func(t *transaction.Transaction) int64
+	// FeeTolerance is the largest fee difference, in subunits, that is not reported as a variance
+	FeeTolerance int64
+	PageSize     int
+	// ListMargin is how long before the period transactions are listed from, as they are listed by creation
+	// date and may be paid some time after they are created. DefaultListMargin is used when it is not set.
+	ListMargin time.Duration
+}
+
+// New creates a Reconciler using the given transaction and settlement services
+func New(transactions transaction.Service, settlements settlement.Service) *Reconciler {
+	return &Reconciler{Transactions: transactions, Settlements: settlements, PageSize: DefaultPageSize}
+}
+
+// Reconcile matches the records dated from from to to against the transactions paid in that period.
+// Records outside the period are ignored. Transactions are matched by reference.
+func (r *Reconciler) Reconcile(ctx context.Context, records []Record, from, to time.Time) (*Report, error) {
+	transactions, err := r.transactions(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	settled, err := r.settledReferences(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{From: from, To: to}
+	seen := map[string]bool{}
+
+	for _, rec := range records {
+		if !within(rec.Date(), from, to) {
+			continue
+		}
+
+		ref := rec.Reference()
+		seen[ref] = true
+		item := Item{
+			Reference:   ref,
+			Currency:    rec.Currency(),
+			LocalAmount: rec.Amount(),
+			Date:        rec.Date(),
+		}
+
+		t, ok := transactions[ref]
+		if !ok {
+			item.Status = StatusMissingOnPaystack
+			report.Items = append(report.Items, item)
+			continue
+		}
+
+		r.fill(&item, t, settled)
+		switch {
+		case t.Amount != item.LocalAmount || t.Currency != item.Currency:
+			item.Status = StatusAmountMismatch
+		case r.ExpectedFee != nil && abs(item.Fees-item.ExpectedFees) > r.FeeTolerance:
+			item.Status = StatusFeeVariance
+		default:
+			item.Status = StatusMatched
+		}
+		report.Items = append(report.Items, item)
+	}
+
+	for ref, t := range transactions {
+		if seen[ref] {
+			continue
+		}
+		item := Item{Status: StatusMissingLocally, Reference: ref, Currency: t.Currency, Date: paidAt(t)}
+		r.fill(&item, t, settled)
+		report.Items = append(report.Items, item)
+	}
+
+	sort.SliceStable(report.Items, func(i, j int) bool {
+		a, b := report.Items[i], report.Items[j]
+		if !a.Date.Equal(b.Date) {
+			return a.Date.Before(b.Date)
+		}
+		return a.Reference < b.Reference
+	})
+	return report, nil
+}
+
+func (r *Reconciler) fill(item *Item, t *transaction.Transaction, settled map[string]int) {
+	item.PaystackAmount = t.Amount
+	item.Fees = t.Fees
+	if r.ExpectedFee != nil {
+		item.ExpectedFees = r.ExpectedFee(t)
+	}
+	item.SettlementID = settled[t.Reference]
+}
+
+// transactions returns the successful transactions paid from from to to, by reference.
+// Paystack lists transactions by creation date, so those created from the list margin before the period
+// are listed, and those paid outside the period are left out.
+func (r *Reconciler) transactions(ctx context.Context, from, to time.Time) (map[string]*transaction.Transaction, error) {
+	transactions := map[string]*transaction.Transaction{}
+
+	opts := &transaction.ListOptions{
+		Status:  "success",
+		From:    from.Add(-r.listMargin()).UTC().Format(time.RFC3339),
+		To:      to.UTC().Format(time.RFC3339),
+		PerPage: r.pageSize(),
+	}
+	for opts.Page = 1; ; opts.Page++ {
+		list, err := r.Transactions.ListWithOptions(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range list.Values {
+			t := &list.Values[i]
+			if t.Status == "success" && within(paidAt(t), from, to) {
+				transactions[t.Reference] = t
+			}
+		}
+
+		if len(list.Values) < r.pageSize() || (list.Meta.PageCount > 0 && opts.Page >= list.Meta.PageCount) {
+			return transactions, nil
+		}
+	}
+}
+
+// settledReferences returns the ID of the settlement that paid out each transaction reference.
+// Settlements of the period and the following week are searched, as transactions settle after they are paid.
+func (r *Reconciler) settledReferences(ctx context.Context, from, to time.Time) (map[string]int, error) {
+	settled := map[string]int{}
+	if r.Settlements == nil {
+		return settled, nil
+	}
+
+	opts := &settlement.ListOptions{
+		From:    from.UTC().Format(time.RFC3339),
+		To:      to.AddDate(0, 0, 7).UTC().Format(time.RFC3339),
+		PerPage: r.pageSize(),
+	}
+	for opts.Page = 1; ; opts.Page++ {
+		list, err := r.Settlements.ListWithOptions(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, s := range list.Values {
+			it := settlement.NewTransactionIterator(r.Settlements, s.ID)
+			it.PageSize = r.pageSize()
+			for it.Next(ctx) {
+				settled[it.Transaction().Reference] = s.ID
+			}
+			if err := it.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(list.Values) < r.pageSize() || (list.Meta.PageCount > 0 && opts.Page >= list.Meta.PageCount) {
+			return settled, nil
+		}
+	}
+}
+
+func (r *Reconciler) pageSize() int {
+	if r.PageSize > 0 {
+		return r.PageSize
+	}
+	return DefaultPageSize
+}
+
+func (r *Reconciler) listMargin() time.Duration {
+	if r.ListMargin > 0 {
+		return r.ListMargin
+	}
+	return DefaultListMargin
+}
+
+func paidAt(t *transaction.Transaction) time.Time {
+	if paid := parseTime(t.PaidAt); !paid.IsZero() {
+		return paid
+	}
+	return parseTime(t.CreatedAt)
+}
+
+func parseTime(s string) time.Time {
+	t, _ := time.Parse(time.RFC3339, s)
+	return t
+}
+
+func within(t, from, to time.Time) bool {
+	return !t.Before(from) && !t.After(to)
+}
+
+func abs(f int64) int64 {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
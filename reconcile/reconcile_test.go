@@ -0,0 +1,238 @@
+package reconcile
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"github.com/hub1989/paystack-api-wrapper/settlement"
+	"github.com/hub1989/paystack-api-wrapper/transaction"
+	"testing"
+	"time"
+)
+
+type order struct {
+	ref      string
+	amount   int64
+	currency string
+	date     time.Time
+}
+
+func (o order) Reference() string { return o.ref }
+func (o order) Amount() int64     { return o.amount }
+func (o order) Currency() string  { return o.currency }
+func (o order) Date() time.Time   { return o.date }
+
+type fakeTransactions struct {
+	transaction.Service
+	values []transaction.Transaction
+	pages  int
+	opts   transaction.ListOptions
+}
+
+func (f *fakeTransactions) ListWithOptions(ctx context.Context, opts *transaction.ListOptions) (*transaction.List, error) {
+	f.pages++
+	f.opts = *opts
+	from, _ := time.Parse(time.RFC3339, opts.From)
+	to, _ := time.Parse(time.RFC3339, opts.To)
+
+	var matching []transaction.Transaction
+	for _, t := range f.values {
+		created, _ := time.Parse(time.RFC3339, t.CreatedAt)
+		if t.Status == opts.Status && within(created, from, to) {
+			matching = append(matching, t)
+		}
+	}
+
+	start := (opts.Page - 1) * opts.PerPage
+	if start > len(matching) {
+		start = len(matching)
+	}
+	end := start + opts.PerPage
+	if end > len(matching) {
+		end = len(matching)
+	}
+	return &transaction.List{Values: matching[start:end]}, nil
+}
+
+type fakeSettlements struct {
+	settlement.Service
+	settlements  []settlement.Settlement
+	transactions map[int][]transaction.Transaction
+}
+
+func (f *fakeSettlements) ListWithOptions(ctx context.Context, opts *settlement.ListOptions) (*settlement.List, error) {
+	if opts.Page > 1 {
+		return &settlement.List{}, nil
+	}
+	return &settlement.List{Values: f.settlements}, nil
+}
+
+func (f *fakeSettlements) ListTransactions(ctx context.Context, settlementID, count, offset int) (*transaction.List, error) {
+	if offset > 1 {
+		return &transaction.List{}, nil
+	}
+	return &transaction.List{Meta: response.ListMeta{PageCount: 1}, Values: f.transactions[settlementID]}, nil
+}
+
+func day(d int) time.Time {
+	return time.Date(2023, time.March, d, 12, 0, 0, 0, time.UTC)
+}
+
+func newReconciler() (*Reconciler, *fakeTransactions) {
+	trx := func(ref string, amount int64, fees int64, paid time.Time) transaction.Transaction {
+		return transaction.Transaction{
+			Reference: ref, Amount: amount, Currency: "NGN", Fees: fees, Status: "success",
+			CreatedAt: paid.Format(time.RFC3339), PaidAt: paid.Format(time.RFC3339),
+		}
+	}
+
+	// newest first, as Paystack lists them
+	transactions := &fakeTransactions{values: []transaction.Transaction{
+		trx("late", 1000, 15, day(20)),
+		trx("unknown", 3000, 45, day(9)),
+		trx("fee", 2000, 100, day(8)),
+		trx("short", 1500, 23, day(7)),
+		trx("ok", 1000, 15, day(6)),
+		{Reference: "abandoned", Amount: 500, Currency: "NGN", Status: "abandoned", CreatedAt: day(5).Format(time.RFC3339)},
+		trx("old", 1000, 15, day(1)),
+		trx("older", 1000, 15, day(1)),
+	}}
+	settlements := &fakeSettlements{
+		settlements:  []settlement.Settlement{{ID: 77}},
+		transactions: map[int][]transaction.Transaction{77: {{Reference: "ok"}, {Reference: "fee"}}},
+	}
+
+	r := New(transactions, settlements)
+	r.PageSize = 3
+	r.ExpectedFee = func(t *transaction.Transaction) int64 { return t.Amount * 15 / 1000 }
+	r.FeeTolerance = 1
+	return r, transactions
+}
+
+func TestReconcile(t *testing.T) {
+	r, transactions := newReconciler()
+
+	records := []Record{
+		order{"ok", 1000, "NGN", day(6)},
+		order{"short", 1200, "NGN", day(7)},
+		order{"fee", 2000, "NGN", day(8)},
+		order{"lost", 800, "NGN", day(9)},
+		order{"outside", 800, "NGN", day(25)},
+	}
+
+	report, err := r.Reconcile(context.TODO(), records, day(5), day(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]Status{
+		"ok":      StatusMatched,
+		"short":   StatusAmountMismatch,
+		"fee":     StatusFeeVariance,
+		"lost":    StatusMissingOnPaystack,
+		"unknown": StatusMissingLocally,
+	}
+	if len(report.Items) != len(want) {
+		t.Fatalf("Expected %d items, got %+v", len(want), report.Items)
+	}
+	for _, item := range report.Items {
+		if want[item.Reference] != item.Status {
+			t.Errorf("Expected %s to be %s, got %s", item.Reference, want[item.Reference], item.Status)
+		}
+	}
+
+	if ok := report.Filter(StatusMatched); len(ok) != 1 || ok[0].SettlementID != 77 || ok[0].Fees != 15 {
+		t.Errorf("Expected ok to be settled in 77 with fees of 15, got %+v", ok)
+	}
+
+	if report.Items[0].Reference != "ok" {
+		t.Errorf("Expected items in date order, got %s first", report.Items[0].Reference)
+	}
+
+	// the transactions of the week before the period are listed too, and left out as they were paid before it
+	if transactions.pages != 3 || transactions.opts.Status != "success" || transactions.opts.From != day(5).Add(-DefaultListMargin).Format(time.RFC3339) {
+		t.Errorf("Expected 3 pages of successful transactions from a week before the period, got %d pages with %+v", transactions.pages, transactions.opts)
+	}
+}
+
+func TestReconcileTransactionPaidAfterCreation(t *testing.T) {
+	r, transactions := newReconciler()
+	r.ExpectedFee = nil
+
+	// created before the period, paid within it
+	transactions.values = []transaction.Transaction{{
+		Reference: "slow", Amount: 1000, Currency: "NGN", Status: "success",
+		CreatedAt: day(3).Format(time.RFC3339), PaidAt: day(6).Format(time.RFC3339),
+	}}
+
+	report, err := r.Reconcile(context.TODO(), []Record{order{"slow", 1000, "NGN", day(6)}}, day(5), day(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Items) != 1 || report.Items[0].Status != StatusMatched {
+		t.Errorf("Expected the transaction paid in the period to be matched, got %+v", report.Items)
+	}
+}
+
+func TestReconcileLargeAmounts(t *testing.T) {
+	r, transactions := newReconciler()
+	r.ExpectedFee = nil
+
+	// 1 kobo apart, above the range float32 holds exactly
+	transactions.values = []transaction.Transaction{{
+		Reference: "large", Amount: 16777216, Currency: "NGN", Status: "success",
+		CreatedAt: day(6).Format(time.RFC3339), PaidAt: day(6).Format(time.RFC3339),
+	}}
+
+	report, err := r.Reconcile(context.TODO(), []Record{order{"large", 16777217, "NGN", day(6)}}, day(5), day(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Items) != 1 || report.Items[0].Status != StatusAmountMismatch {
+		t.Errorf("Expected a 1 kobo mismatch to be reported, got %+v", report.Items)
+	}
+}
+
+func TestReportExport(t *testing.T) {
+	r, _ := newReconciler()
+	report, err := r.Reconcile(context.TODO(), []Record{order{"ok", 1000, "NGN", day(6)}}, day(5), day(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := report.WriteCSV(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := csv.NewReader(buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != len(report.Items)+1 || rows[0][0] != "status" {
+		t.Fatalf("Expected a header and %d rows, got %v", len(report.Items), rows)
+	}
+	if rows[1][0] != string(StatusMatched) || rows[1][1] != "ok" || rows[1][3] != "1000" || rows[1][7] != "77" {
+		t.Errorf("Unexpected csv row %v", rows[1])
+	}
+
+	buf.Reset()
+	if err := report.WriteJSON(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	decoded := struct {
+		Summary map[Status]int `json:"summary"`
+		Items   []Item         `json:"items"`
+	}{}
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Summary[StatusMatched] != 1 || decoded.Summary[StatusMissingLocally] != 3 || len(decoded.Items) != 4 {
+		t.Errorf("Unexpected json report %+v", decoded)
+	}
+}
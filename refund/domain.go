@@ -1,48 +1,69 @@
 package refund
 
+import (
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"github.com/hub1989/paystack-api-wrapper/transaction"
+)
+
+// Status is the processing state of a refund
+type Status string
+
+const (
+	StatusPending    Status = "pending"
+	StatusProcessing Status = "processing"
+	StatusProcessed  Status = "processed"
+	StatusFailed     Status = "failed"
+)
+
+// Request represents a request to refund a transaction.
+// Amount and Currency are optional; the full transaction amount is refunded when Amount is not set.
+// For more details see https://paystack.com/docs/api/refund/#create
+type Request struct {
+	// Transaction is the ID or reference of the transaction to refund
+	Transaction  string  `json:"transaction"`
+	Amount       float32 `json:"amount,omitempty"`
+	Currency     string  `json:"currency,omitempty"`
+	CustomerNote string  `json:"customer_note,omitempty"`
+	MerchantNote string  `json:"merchant_note,omitempty"`
+}
+
+// Response is the resource representing a Paystack refund.
+// Only the transaction ID is set on refunds returned by List and Fetch.
+// Amounts are in the currency's subunit.
 type Response struct {
-	Transaction struct {
-		Id            int64  `json:"id,omitempty"`
-		Domain        string `json:"domain,omitempty"`
-		Reference     string `json:"reference,omitempty"`
-		Amount        int    `json:"amount,omitempty"`
-		PaidAt        string `json:"paid_at,omitempty"`
-		Channel       string `json:"channel,omitempty"`
-		Currency      string `json:"currency,omitempty"`
-		Authorization struct {
-			ExpMonth    interface{} `json:"exp_month,omitempty"`
-			ExpYear     interface{} `json:"exp_year,omitempty"`
-			AccountName interface{} `json:"account_name,omitempty"`
-		} `json:"authorization,omitempty"`
-		Customer struct {
-			InternationalFormatPhone interface{} `json:"international_format_phone,omitempty"`
-		} `json:"customer,omitempty"`
-		Plan struct {
-		} `json:"plan,omitempty"`
-		Subaccount struct {
-			Currency interface{} `json:"currency,omitempty"`
-		} `json:"subaccount,omitempty"`
-		Split struct {
-		} `json:"split,omitempty"`
-		OrderId            interface{} `json:"order_id,omitempty"`
-		PaidAt1            string      `json:"paidAt,omitempty"`
-		PosTransactionData interface{} `json:"pos_transaction_data,omitempty"`
-		Source             interface{} `json:"source,omitempty"`
-		FeesBreakdown      interface{} `json:"fees_breakdown,omitempty"`
-	} `json:"transaction,omitempty"`
-	Integration    int         `json:"integration,omitempty"`
-	DeductedAmount int         `json:"deducted_amount,omitempty"`
-	Channel        interface{} `json:"channel,omitempty"`
-	MerchantNote   string      `json:"merchant_note,omitempty"`
-	CustomerNote   string      `json:"customer_note,omitempty"`
-	Status         string      `json:"status,omitempty"`
-	RefundedBy     string      `json:"refunded_by,omitempty"`
-	ExpectedAt     string      `json:"expected_at,omitempty"`
-	Currency       string      `json:"currency,omitempty"`
-	Domain         string      `json:"domain,omitempty"`
-	Amount         int         `json:"amount,omitempty"`
-	FullyDeducted  bool        `json:"fully_deducted,omitempty"`
-	Id             int         `json:"id,omitempty"`
-	CreatedAt      string      `json:"createdAt,omitempty"`
-	UpdatedAt      string      `json:"updatedAt,omitempty"`
+	ID             int                     `json:"id,omitempty"`
+	Integration    int                     `json:"integration,omitempty"`
+	Domain         string                  `json:"domain,omitempty"`
+	Transaction    transaction.Transaction `json:"transaction,omitempty"`
+	DeductedAmount int64                   `json:"deducted_amount,omitempty"`
+	Channel        string                  `json:"channel,omitempty"`
+	MerchantNote   string                  `json:"merchant_note,omitempty"`
+	CustomerNote   string                  `json:"customer_note,omitempty"`
+	Status         Status                  `json:"status,omitempty"`
+	RefundedBy     string                  `json:"refunded_by,omitempty"`
+	RefundedAt     string                  `json:"refunded_at,omitempty"`
+	ExpectedAt     string                  `json:"expected_at,omitempty"`
+	Currency       string                  `json:"currency,omitempty"`
+	Amount         int64                   `json:"amount,omitempty"`
+	FullyDeducted  bool                    `json:"fully_deducted,omitempty"`
+	CreatedAt      string                  `json:"createdAt,omitempty"`
+	UpdatedAt      string                  `json:"updatedAt,omitempty"`
+}
+
+// ListOptions filters the refunds returned by List
+type ListOptions struct {
+	// Transaction is the ID of the refunded transaction
+	Transaction string
+	Currency    string
+	// From and To limit the creation date range, e.g. 2016-09-21T00:00:00.000Z
+	From    string
+	To      string
+	PerPage int
+	Page    int
+}
+
+// List is a list object for refunds.
+type List struct {
+	Meta   response.ListMeta
+	Values []Response `json:"data"`
 }
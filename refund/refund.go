@@ -4,40 +4,108 @@ import (
 	"context"
 	"fmt"
 	"github.com/hub1989/paystack-api-wrapper/client"
+	"github.com/hub1989/paystack-api-wrapper/response"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
 type Service interface {
+	Create(ctx context.Context, req *Request) (*Response, error)
 	RefundById(ctx context.Context, id int64) (*Response, error)
 	RefundByReference(ctx context.Context, reference string) (*Response, error)
+	List(ctx context.Context, opts *ListOptions) (*List, error)
+	Fetch(ctx context.Context, id int) (*Response, error)
 }
 
+// DefaultRefundService handles operations related to refunds
+// For more details see https://paystack.com/docs/api/refund/
 type DefaultRefundService struct {
 	*client.Client
 }
 
-func (d DefaultRefundService) RefundById(ctx context.Context, id int64) (*Response, error) {
-	endpoint := fmt.Sprintf("/refund")
-	request := struct {
-		Transaction int64 `json:"transaction"`
-	}{
-		Transaction: id,
+// Create refunds a transaction, fully or partially
+// For more details see https://paystack.com/docs/api/refund/#create
+func (d DefaultRefundService) Create(ctx context.Context, req *Request) (*Response, error) {
+	resp := response.Response{}
+	if err := d.Client.Call(ctx, http.MethodPost, "/refund", req, &resp); err != nil {
+		return nil, err
 	}
 
-	response := &Response{}
-	err := d.Client.Call(ctx, http.MethodPost, endpoint, &request, response)
-	return response, err
+	refund := &Response{}
+	err := decode(resp, refund)
+	return refund, err
 }
 
+// RefundById refunds the full amount of the transaction with the given ID
+func (d DefaultRefundService) RefundById(ctx context.Context, id int64) (*Response, error) {
+	return d.Create(ctx, &Request{Transaction: strconv.FormatInt(id, 10)})
+}
+
+// RefundByReference refunds the full amount of the transaction with the given reference
 func (d DefaultRefundService) RefundByReference(ctx context.Context, reference string) (*Response, error) {
-	endpoint := fmt.Sprintf("/refund")
-	request := struct {
-		Transaction string `json:"transaction"`
-	}{
-		Transaction: reference,
+	return d.Create(ctx, &Request{Transaction: reference})
+}
+
+// List returns the refunds matching opts
+// For more details see https://paystack.com/docs/api/refund/#list
+func (d DefaultRefundService) List(ctx context.Context, opts *ListOptions) (*List, error) {
+	params := url.Values{}
+	if opts != nil {
+		params.Set("transaction", opts.Transaction)
+		params.Set("currency", opts.Currency)
+		params.Set("from", opts.From)
+		params.Set("to", opts.To)
+		if opts.PerPage > 0 {
+			params.Set("perPage", strconv.Itoa(opts.PerPage))
+		}
+		if opts.Page > 0 {
+			params.Set("page", strconv.Itoa(opts.Page))
+		}
+	}
+
+	resp := response.Response{}
+	if err := d.Client.Call(ctx, http.MethodGet, client.AddQuery("/refund", params), nil, &resp); err != nil {
+		return nil, err
+	}
+
+	refunds := &List{}
+	err := decode(resp, refunds)
+	return refunds, err
+}
+
+// Fetch returns the details of a refund
+// For more details see https://paystack.com/docs/api/refund/#fetch
+func (d DefaultRefundService) Fetch(ctx context.Context, id int) (*Response, error) {
+	resp := response.Response{}
+	if err := d.Client.Call(ctx, http.MethodGet, fmt.Sprintf("/refund/%d", id), nil, &resp); err != nil {
+		return nil, err
 	}
 
-	response := &Response{}
-	err := d.Client.Call(ctx, http.MethodPost, endpoint, &request, response)
-	return response, err
+	refund := &Response{}
+	err := decode(resp, refund)
+	return refund, err
+}
+
+// decode decodes resp into v. Paystack returns the refunded transaction as an object
+// when a refund is created but only as its ID when refunds are listed or fetched.
+func decode(resp response.Response, v interface{}) error {
+	if data, ok := resp["data"].([]interface{}); ok {
+		for _, item := range data {
+			if refund, ok := item.(map[string]interface{}); ok {
+				wrapTransaction(refund)
+			}
+		}
+	} else {
+		wrapTransaction(resp)
+	}
+	return client.Decode(resp, v)
+}
+
+func wrapTransaction(refund map[string]interface{}) {
+	switch trx := refund["transaction"].(type) {
+	case map[string]interface{}, nil:
+	default:
+		refund["transaction"] = map[string]interface{}{"id": trx}
+	}
 }
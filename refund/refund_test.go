@@ -0,0 +1,103 @@
+package refund
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hub1989/paystack-api-wrapper/configuration"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func newService(t *testing.T, handler http.HandlerFunc) *DefaultRefundService {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c := configuration.NewClient("sk_test", server.Client(), false)
+	c.BaseURL, _ = url.Parse(server.URL)
+	return &DefaultRefundService{Client: c}
+}
+
+func TestCreatePartialRefund(t *testing.T) {
+	var body Request
+	service := newService(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": true,
+			"data": map[string]interface{}{
+				"id":          3018284,
+				"transaction": map[string]interface{}{"id": 1004723697, "reference": "T685312322670591", "amount": 10000},
+				"amount":      5000,
+				"status":      "pending",
+			},
+		})
+	})
+
+	refund, err := service.Create(context.TODO(), &Request{Transaction: "T685312322670591", Amount: 5000, MerchantNote: "damaged"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if body.Amount != 5000 || body.MerchantNote != "damaged" {
+		t.Errorf("Expected partial refund request, got %+v", body)
+	}
+
+	if refund.Status != StatusPending || refund.Transaction.Reference != "T685312322670591" {
+		t.Errorf("Expected pending refund of T685312322670591, got %+v", refund)
+	}
+}
+
+func TestListRefunds(t *testing.T) {
+	var query url.Values
+	service := newService(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": true,
+			"data": []interface{}{
+				map[string]interface{}{"id": 1, "transaction": 1641, "amount": 500000, "status": "processed"},
+			},
+			"meta": map[string]interface{}{"total": 1, "perPage": 50, "page": 1},
+		})
+	})
+
+	refunds, err := service.List(context.TODO(), &ListOptions{Transaction: "1641", Currency: "NGN"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if query.Get("transaction") != "1641" || query.Get("currency") != "NGN" || query.Has("from") {
+		t.Errorf("Unexpected list query %v", query)
+	}
+
+	if len(refunds.Values) != 1 || refunds.Meta.Total != 1 {
+		t.Fatalf("Expected 1 refund, got %+v", refunds)
+	}
+
+	if refunds.Values[0].Transaction.ID != 1641 || refunds.Values[0].Status != StatusProcessed {
+		t.Errorf("Expected processed refund of transaction 1641, got %+v", refunds.Values[0])
+	}
+}
+
+func TestFetchRefundWithNumericStrings(t *testing.T) {
+	service := newService(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": true,
+			"data": map[string]interface{}{
+				"id":          "3018284",
+				"transaction": "1641",
+				"amount":      "5000",
+				"status":      "processed",
+			},
+		})
+	})
+
+	refund, err := service.Fetch(context.TODO(), 3018284)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if refund.Amount != 5000 || refund.Transaction.ID != 1641 {
+		t.Errorf("Expected numeric strings to be decoded, got %+v", refund)
+	}
+}
@@ -7,6 +7,10 @@ import (
 // RequestValues aliased to url.Values as a workaround
 type RequestValues url.Values
 
+// Reference is the ID of a resource that Paystack returns as an ID from some endpoints and as the whole
+// resource from others, e.g. the plan of a subscription. The client decodes an embedded resource into its ID.
+type Reference string
+
 // ListMeta is pagination metadata for paginated responses from the Paystack API
 type ListMeta struct {
 	Total     int `json:"total"`
@@ -14,4 +18,7 @@ type ListMeta struct {
 	PerPage   int `json:"perPage"`
 	Page      int `json:"page"`
 	PageCount int `json:"pageCount"`
+	// Next and Previous are the cursors of the adjacent pages on endpoints using cursor pagination
+	Next     string `json:"next"`
+	Previous string `json:"previous"`
 }
@@ -1,9 +1,57 @@
 package settlement
 
-import "github.com/hub1989/paystack-api-wrapper/response"
+import (
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"github.com/hub1989/paystack-api-wrapper/subaccount"
+)
+
+// Status is the state of a settlement
+type Status string
+
+const (
+	StatusSuccess    Status = "success"
+	StatusProcessing Status = "processing"
+	StatusPending    Status = "pending"
+	StatusFailed     Status = "failed"
+)
+
+// Settlement is a payout of collected funds to the integration's or a subaccount's bank account.
+// Amounts are in the currency's subunit.
+// For more details see https://paystack.com/docs/api/settlement/
+type Settlement struct {
+	ID             int    `json:"id,omitempty"`
+	Integration    int    `json:"integration,omitempty"`
+	Domain         string `json:"domain,omitempty"`
+	Status         Status `json:"status,omitempty"`
+	Currency       string `json:"currency,omitempty"`
+	TotalAmount    int64  `json:"total_amount"`
+	TotalFees      int64  `json:"total_fees"`
+	TotalProcessed int64  `json:"total_processed"`
+	// EffectiveAmount is the amount paid out after deductions
+	EffectiveAmount int64 `json:"effective_amount"`
+	Deductions      int64 `json:"deductions"`
+	// SettlementDate is when the settlement was processed
+	SettlementDate string                `json:"settlement_date,omitempty"`
+	SettledBy      string                `json:"settled_by,omitempty"`
+	SubAccount     subaccount.SubAccount `json:"subaccount,omitempty"`
+	CreatedAt      string                `json:"createdAt,omitempty"`
+	UpdatedAt      string                `json:"updatedAt,omitempty"`
+}
+
+// ListOptions filters the settlements returned by ListWithOptions
+type ListOptions struct {
+	Status Status
+	// From and To limit the settlement date range, e.g. 2016-09-21T00:00:00.000Z
+	From string
+	To   string
+	// SubAccount is the ID of a subaccount, or "none" for the settlements of the main account only
+	SubAccount string
+	PerPage    int
+	Page       int
+}
 
 // List is a list object for settlements.
 type List struct {
 	Meta   response.ListMeta
-	Values []response.Response `json:"data,omitempty"`
+	Values []Settlement `json:"data,omitempty"`
 }
@@ -0,0 +1,73 @@
+package settlement
+
+import (
+	"context"
+	"github.com/hub1989/paystack-api-wrapper/transaction"
+)
+
+// DefaultIteratorPageSize is the number of transactions a TransactionIterator requests per page
+const DefaultIteratorPageSize = 100
+
+// TransactionIterator walks through every transaction of a settlement, fetching pages as needed.
+//
+//	it := settlement.NewTransactionIterator(service, settlementID)
+//	for it.Next(ctx) {
+//		trx := it.Transaction()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type TransactionIterator struct {
+	Service      Service
+	SettlementID int
+	PageSize     int
+
+	page    int
+	buf     []transaction.Transaction
+	current transaction.Transaction
+	last    bool
+	err     error
+}
+
+// NewTransactionIterator creates an iterator over the transactions of the given settlement
+func NewTransactionIterator(service Service, settlementID int) *TransactionIterator {
+	return &TransactionIterator{Service: service, SettlementID: settlementID, PageSize: DefaultIteratorPageSize}
+}
+
+// Next advances to the next transaction. It returns false when there are no more transactions or a page fails to load.
+func (it *TransactionIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	if len(it.buf) == 0 {
+		if it.last {
+			return false
+		}
+
+		it.page++
+		list, err := it.Service.ListTransactions(ctx, it.SettlementID, it.PageSize, it.page)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.buf = list.Values
+		it.last = len(list.Values) < it.PageSize || (list.Meta.PageCount > 0 && it.page >= list.Meta.PageCount)
+		if len(it.buf) == 0 {
+			return false
+		}
+	}
+
+	it.current, it.buf = it.buf[0], it.buf[1:]
+	return true
+}
+
+// Transaction returns the transaction Next advanced to
+func (it *TransactionIterator) Transaction() transaction.Transaction {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *TransactionIterator) Err() error {
+	return it.err
+}
@@ -2,13 +2,19 @@ package settlement
 
 import (
 	"context"
+	"fmt"
 	"github.com/hub1989/paystack-api-wrapper/client"
+	"github.com/hub1989/paystack-api-wrapper/transaction"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
 type Service interface {
 	List(ctx context.Context) (*List, error)
 	ListN(ctx context.Context, count, offset int) (*List, error)
+	ListWithOptions(ctx context.Context, opts *ListOptions) (*List, error)
+	ListTransactions(ctx context.Context, settlementID, count, offset int) (*transaction.List, error)
 }
 
 // DefaultSettlementService handles operations related to the settlement
@@ -31,3 +37,34 @@ func (s *DefaultSettlementService) ListN(ctx context.Context, count, offset int)
 	err := s.Client.Call(ctx, http.MethodGet, u, nil, pg)
 	return pg, err
 }
+
+// ListWithOptions returns the settlements matching opts
+// For more details see https://paystack.com/docs/api/settlement/#list
+func (s *DefaultSettlementService) ListWithOptions(ctx context.Context, opts *ListOptions) (*List, error) {
+	params := url.Values{}
+	if opts != nil {
+		params.Set("status", string(opts.Status))
+		params.Set("from", opts.From)
+		params.Set("to", opts.To)
+		params.Set("subaccount", opts.SubAccount)
+		if opts.PerPage > 0 {
+			params.Set("perPage", strconv.Itoa(opts.PerPage))
+		}
+		if opts.Page > 0 {
+			params.Set("page", strconv.Itoa(opts.Page))
+		}
+	}
+
+	pg := &List{}
+	err := s.Client.Call(ctx, http.MethodGet, client.AddQuery("/settlement", params), nil, pg)
+	return pg, err
+}
+
+// ListTransactions returns the transactions paid out in a settlement
+// For more details see https://paystack.com/docs/api/settlement/#transactions
+func (s *DefaultSettlementService) ListTransactions(ctx context.Context, settlementID, count, offset int) (*transaction.List, error) {
+	u := client.PaginateURL(fmt.Sprintf("/settlement/%d/transactions", settlementID), count, offset)
+	transactions := &transaction.List{}
+	err := s.Client.Call(ctx, http.MethodGet, u, nil, transactions)
+	return transactions, err
+}
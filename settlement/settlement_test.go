@@ -2,9 +2,15 @@ package settlement
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hub1989/paystack-api-wrapper/client"
 	"github.com/hub1989/paystack-api-wrapper/configuration"
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"github.com/hub1989/paystack-api-wrapper/transaction"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 )
 
@@ -29,3 +35,100 @@ func TestSettlementList(t *testing.T) {
 		fmt.Printf("Settlements total: %d", len(settlements.Values))
 	}
 }
+
+func TestSettlementListWithOptions(t *testing.T) {
+	settlements, err := service.ListWithOptions(context.TODO(), &ListOptions{Status: StatusSuccess, SubAccount: "none", PerPage: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range settlements.Values {
+		if s.Status != StatusSuccess {
+			t.Errorf("Expected only successful settlements, got %+v", s)
+		}
+	}
+}
+
+type fakeSettlements struct {
+	Service
+	transactions []transaction.Transaction
+	failOnPage   int
+	calls        int
+}
+
+func (f *fakeSettlements) ListTransactions(ctx context.Context, settlementID, count, offset int) (*transaction.List, error) {
+	f.calls++
+	if offset == f.failOnPage {
+		return nil, errors.New("page failed")
+	}
+
+	start := (offset - 1) * count
+	end := start + count
+	if end > len(f.transactions) {
+		end = len(f.transactions)
+	}
+	pageCount := (len(f.transactions) + count - 1) / count
+	return &transaction.List{
+		Meta:   response.ListMeta{Total: len(f.transactions), PerPage: count, Page: offset, PageCount: pageCount},
+		Values: f.transactions[start:end],
+	}, nil
+}
+
+func TestTransactionIterator(t *testing.T) {
+	fake := &fakeSettlements{}
+	for i := 1; i <= 5; i++ {
+		fake.transactions = append(fake.transactions, transaction.Transaction{ID: i})
+	}
+
+	it := NewTransactionIterator(fake, 42)
+	it.PageSize = 2
+
+	var ids []int
+	for it.Next(context.TODO()) {
+		ids = append(ids, it.Transaction().ID)
+	}
+
+	if it.Err() != nil {
+		t.Fatal(it.Err())
+	}
+	if len(ids) != 5 || ids[0] != 1 || ids[4] != 5 {
+		t.Errorf("Expected transactions 1 to 5, got %v", ids)
+	}
+	if fake.calls != 3 {
+		t.Errorf("Expected 3 page requests, got %d", fake.calls)
+	}
+
+	fake.failOnPage = 2
+	it = NewTransactionIterator(fake, 42)
+	it.PageSize = 2
+
+	count := 0
+	for it.Next(context.TODO()) {
+		count++
+	}
+	if count != 2 || it.Err() == nil {
+		t.Errorf("Expected the iteration to stop with an error after 2 transactions, got %d and %v", count, it.Err())
+	}
+}
+
+func TestSettlementLargeTotals(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status": true, "data": [{"id": 1, "total_amount": 2500000001, "total_fees": 16777217, "total_processed": 2500000001, "effective_amount": 2483222784, "deductions": 0}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c := configuration.NewClient("sk_test", server.Client(), false)
+	c.BaseURL, _ = url.Parse(server.URL)
+	service := &DefaultSettlementService{Client: c}
+
+	list, err := service.List(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// beyond the range float32 holds exactly
+	s := list.Values[0]
+	if s.TotalAmount != 2500000001 || s.TotalFees != 16777217 || s.EffectiveAmount != s.TotalProcessed-s.TotalFees {
+		t.Errorf("Expected exact kobo totals, got %+v", s)
+	}
+}
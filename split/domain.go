@@ -0,0 +1,95 @@
+package split
+
+import (
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"github.com/hub1989/paystack-api-wrapper/subaccount"
+)
+
+// Type is how a split shares a payment between subaccounts
+type Type string
+
+const (
+	TypePercentage Type = "percentage"
+	TypeFlat       Type = "flat"
+)
+
+// BearerType is who bears the Paystack fees of a split payment
+type BearerType string
+
+const (
+	BearerSubaccount      BearerType = "subaccount"
+	BearerAccount         BearerType = "account"
+	BearerAllProportional BearerType = "all-proportional"
+	BearerAll             BearerType = "all"
+)
+
+// Split is the resource representing a Paystack transaction split.
+// For more details see https://paystack.com/docs/api/split/
+type Split struct {
+	ID               int               `json:"id,omitempty"`
+	CreatedAt        string            `json:"createdAt,omitempty"`
+	UpdatedAt        string            `json:"updatedAt,omitempty"`
+	Domain           string            `json:"domain,omitempty"`
+	Integration      int               `json:"integration,omitempty"`
+	Name             string            `json:"name,omitempty"`
+	Type             Type              `json:"type,omitempty"`
+	Currency         string            `json:"currency,omitempty"`
+	SplitCode        string            `json:"split_code,omitempty"`
+	Active           bool              `json:"active,omitempty"`
+	BearerType       BearerType        `json:"bearer_type,omitempty"`
+	BearerSubaccount string            `json:"bearer_subaccount,omitempty"`
+	IsDynamic        bool              `json:"is_dynamic,omitempty"`
+	Subaccounts      []SubAccountShare `json:"subaccounts,omitempty"`
+	TotalSubaccounts int               `json:"total_subaccounts,omitempty"`
+}
+
+// SubAccountShare is a subaccount's share of a split
+type SubAccountShare struct {
+	SubAccount subaccount.SubAccount `json:"subaccount,omitempty"`
+	Share      float32               `json:"share,omitempty"`
+}
+
+// Share is a subaccount's share in a split request.
+// It is a percentage for percentage splits and an amount in the currency's subunit for flat splits.
+type Share struct {
+	SubAccount string  `json:"subaccount,omitempty"`
+	Share      float32 `json:"share,omitempty"`
+}
+
+// Request represents a request to create a split
+type Request struct {
+	Name        string     `json:"name,omitempty"`
+	Type        Type       `json:"type,omitempty"`
+	Currency    string     `json:"currency,omitempty"`
+	SubAccounts []Share    `json:"subaccounts,omitempty"`
+	BearerType  BearerType `json:"bearer_type,omitempty"`
+	// subaccount code of the bearer when BearerType is subaccount
+	BearerSubaccount string `json:"bearer_subaccount,omitempty"`
+}
+
+// UpdateRequest represents a request to update a split
+type UpdateRequest struct {
+	Name             string     `json:"name,omitempty"`
+	Active           *bool      `json:"active,omitempty"`
+	BearerType       BearerType `json:"bearer_type,omitempty"`
+	BearerSubaccount string     `json:"bearer_subaccount,omitempty"`
+}
+
+// ListOptions filters the splits returned by List
+type ListOptions struct {
+	Name string
+	// Active filters by status when set
+	Active *bool
+	SortBy string
+	// From and To limit the creation date range, e.g. 2019-09-24T00:00:05.000Z
+	From    string
+	To      string
+	PerPage int
+	Page    int
+}
+
+// List is a list object for splits.
+type List struct {
+	Meta   response.ListMeta
+	Values []Split `json:"data"`
+}
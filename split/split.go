@@ -0,0 +1,111 @@
+package split
+
+import (
+	"context"
+	"fmt"
+	"github.com/hub1989/paystack-api-wrapper/client"
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type Service interface {
+	Create(ctx context.Context, req *Request) (*Split, error)
+	List(ctx context.Context, opts *ListOptions) (*List, error)
+	Get(ctx context.Context, id int) (*Split, error)
+	Update(ctx context.Context, id int, req *UpdateRequest) (*Split, error)
+	AddSubAccount(ctx context.Context, id int, share Share) (*Split, error)
+	UpdateSubAccount(ctx context.Context, id int, share Share) (*Split, error)
+	RemoveSubAccount(ctx context.Context, id int, subaccountCode string) (response.Response, error)
+}
+
+// DefaultSplitService handles operations related to transaction splits
+// For more details see https://paystack.com/docs/api/split/
+type DefaultSplitService struct {
+	*client.Client
+}
+
+// Create validates and creates a split
+// For more details see https://paystack.com/docs/api/split/#create
+func (s *DefaultSplitService) Create(ctx context.Context, req *Request) (*Split, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	split := &Split{}
+	err := s.Client.Call(ctx, http.MethodPost, "/split", req, split)
+	return split, err
+}
+
+// List returns the splits matching opts
+// For more details see https://paystack.com/docs/api/split/#list
+func (s *DefaultSplitService) List(ctx context.Context, opts *ListOptions) (*List, error) {
+	params := url.Values{}
+	if opts != nil {
+		params.Set("name", opts.Name)
+		if opts.Active != nil {
+			params.Set("active", strconv.FormatBool(*opts.Active))
+		}
+		params.Set("sort_by", opts.SortBy)
+		params.Set("from", opts.From)
+		params.Set("to", opts.To)
+		if opts.PerPage > 0 {
+			params.Set("perPage", strconv.Itoa(opts.PerPage))
+		}
+		if opts.Page > 0 {
+			params.Set("page", strconv.Itoa(opts.Page))
+		}
+	}
+
+	splits := &List{}
+	err := s.Client.Call(ctx, http.MethodGet, client.AddQuery("/split", params), nil, splits)
+	return splits, err
+}
+
+// Get returns the details of a split
+// For more details see https://paystack.com/docs/api/split/#fetch
+func (s *DefaultSplitService) Get(ctx context.Context, id int) (*Split, error) {
+	u := fmt.Sprintf("/split/%d", id)
+	split := &Split{}
+	err := s.Client.Call(ctx, http.MethodGet, u, nil, split)
+	return split, err
+}
+
+// Update updates a split's name, status or bearer
+// For more details see https://paystack.com/docs/api/split/#update
+func (s *DefaultSplitService) Update(ctx context.Context, id int, req *UpdateRequest) (*Split, error) {
+	u := fmt.Sprintf("/split/%d", id)
+	split := &Split{}
+	err := s.Client.Call(ctx, http.MethodPut, u, req, split)
+	return split, err
+}
+
+// AddSubAccount adds a subaccount to a split
+// For more details see https://paystack.com/docs/api/split/#add-subaccount
+func (s *DefaultSplitService) AddSubAccount(ctx context.Context, id int, share Share) (*Split, error) {
+	u := fmt.Sprintf("/split/%d/subaccount/add", id)
+	split := &Split{}
+	err := s.Client.Call(ctx, http.MethodPost, u, share, split)
+	return split, err
+}
+
+// UpdateSubAccount changes the share of a subaccount already in a split.
+// Paystack adds and updates subaccounts through the same endpoint.
+// For more details see https://paystack.com/docs/api/split/#add-subaccount
+func (s *DefaultSplitService) UpdateSubAccount(ctx context.Context, id int, share Share) (*Split, error) {
+	return s.AddSubAccount(ctx, id, share)
+}
+
+// RemoveSubAccount removes a subaccount from a split
+// For more details see https://paystack.com/docs/api/split/#remove-subaccount
+func (s *DefaultSplitService) RemoveSubAccount(ctx context.Context, id int, subaccountCode string) (response.Response, error) {
+	u := fmt.Sprintf("/split/%d/subaccount/remove", id)
+	reqBody := struct {
+		SubAccount string `json:"subaccount"`
+	}{
+		SubAccount: subaccountCode,
+	}
+	resp := response.Response{}
+	err := s.Client.Call(ctx, http.MethodPost, u, reqBody, &resp)
+	return resp, err
+}
@@ -0,0 +1,61 @@
+package split
+
+import (
+	"context"
+	"errors"
+	"github.com/hub1989/paystack-api-wrapper/client"
+	"github.com/hub1989/paystack-api-wrapper/configuration"
+	"testing"
+)
+
+var c *client.Client
+var service *DefaultSplitService
+
+func init() {
+	apiKey := client.MustGetTestKey()
+	c = configuration.NewClient(apiKey, nil, true)
+	service = &DefaultSplitService{Client: c}
+}
+
+func TestSplitList(t *testing.T) {
+	splits, err := service.List(context.TODO(), &ListOptions{PerPage: 10, Page: 1})
+	if err != nil {
+		t.Errorf("Expected Split list, got %d, returned error %v", len(splits.Values), err)
+	}
+}
+
+func TestSplitValidate(t *testing.T) {
+	req := &Request{
+		Name:     "Halfsies",
+		Type:     TypePercentage,
+		Currency: "NGN",
+		SubAccounts: []Share{
+			{SubAccount: "ACCT_one", Share: 30},
+			{SubAccount: "ACCT_two", Share: 20},
+		},
+		BearerType:       BearerSubaccount,
+		BearerSubaccount: "ACCT_one",
+	}
+	if err := req.Validate(); err != nil {
+		t.Errorf("Expected split to be valid, got %v", err)
+	}
+
+	req.SubAccounts[1].Share = 80
+	if err := req.Validate(); !errors.Is(err, ErrInvalidSplit) {
+		t.Errorf("Expected shares over 100%% to be rejected, got %v", err)
+	}
+
+	req.Type = TypeFlat
+	if err := req.ValidateAmount(10000); err != nil {
+		t.Errorf("Expected flat split to fit in the amount, got %v", err)
+	}
+
+	if err := req.ValidateAmount(100); !errors.Is(err, ErrInvalidSplit) {
+		t.Errorf("Expected flat shares over the amount to be rejected, got %v", err)
+	}
+
+	req.BearerSubaccount = "ACCT_three"
+	if _, err := service.Create(context.TODO(), req); !errors.Is(err, ErrInvalidSplit) {
+		t.Errorf("Expected unknown bearer subaccount to be rejected before create, got %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+package split
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidSplit is returned when a split request fails validation before it is sent to Paystack
+var ErrInvalidSplit = errors.New("split: invalid split")
+
+// Validate checks the split type, bearer and subaccount shares.
+// Percentage shares must add up to at most 100; the main account keeps the rest.
+func (r *Request) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidSplit)
+	}
+	if r.Currency == "" {
+		return fmt.Errorf("%w: currency is required", ErrInvalidSplit)
+	}
+	if r.Type != TypePercentage && r.Type != TypeFlat {
+		return fmt.Errorf("%w: type must be %s or %s, got %q", ErrInvalidSplit, TypePercentage, TypeFlat, r.Type)
+	}
+	if len(r.SubAccounts) == 0 {
+		return fmt.Errorf("%w: at least one subaccount is required", ErrInvalidSplit)
+	}
+
+	seen := map[string]bool{}
+	for _, s := range r.SubAccounts {
+		if s.SubAccount == "" {
+			return fmt.Errorf("%w: subaccount code is required", ErrInvalidSplit)
+		}
+		if seen[s.SubAccount] {
+			return fmt.Errorf("%w: subaccount %s is listed more than once", ErrInvalidSplit, s.SubAccount)
+		}
+		seen[s.SubAccount] = true
+
+		if s.Share <= 0 {
+			return fmt.Errorf("%w: share of subaccount %s must be positive", ErrInvalidSplit, s.SubAccount)
+		}
+	}
+
+	if total := r.Total(); r.Type == TypePercentage && total > 100 {
+		return fmt.Errorf("%w: percentage shares add up to %v, more than 100", ErrInvalidSplit, total)
+	}
+
+	switch r.BearerType {
+	case "", BearerAccount, BearerAll, BearerAllProportional:
+	case BearerSubaccount:
+		if !seen[r.BearerSubaccount] {
+			return fmt.Errorf("%w: bearer subaccount %q is not part of the split", ErrInvalidSplit, r.BearerSubaccount)
+		}
+	default:
+		return fmt.Errorf("%w: unknown bearer type %q", ErrInvalidSplit, r.BearerType)
+	}
+	return nil
+}
+
+// ValidateAmount checks that the flat shares of the split fit in a payment of amount, in the currency's subunit
+func (r *Request) ValidateAmount(amount float32) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	if total := r.Total(); r.Type == TypeFlat && total > amount {
+		return fmt.Errorf("%w: flat shares add up to %v, more than the amount %v", ErrInvalidSplit, total, amount)
+	}
+	return nil
+}
+
+// Total returns the sum of the subaccount shares
+func (r *Request) Total() float32 {
+	var total float32
+	for _, s := range r.SubAccounts {
+		total += s.Share
+	}
+	return total
+}
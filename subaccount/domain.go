@@ -3,30 +3,61 @@ package subaccount
 import (
 	"github.com/hub1989/paystack-api-wrapper/client"
 	"github.com/hub1989/paystack-api-wrapper/response"
+	"strings"
+)
+
+// SettlementSchedule is how often a subaccount is paid out.
+// Paystack takes the schedule in lower case and returns it in upper case; the subaccount service
+// lower-cases the schedules it returns, so they compare equal to the constants below.
+type SettlementSchedule string
+
+const (
+	// ScheduleAuto settles the day after payment (T+1)
+	ScheduleAuto    SettlementSchedule = "auto"
+	ScheduleWeekly  SettlementSchedule = "weekly"
+	ScheduleMonthly SettlementSchedule = "monthly"
+	// ScheduleManual only settles when asked to
+	ScheduleManual SettlementSchedule = "manual"
 )
 
 // SubAccount is the resource representing your Paystack subaccount.
 // For more details see https://developers.paystack.co/v1.0/reference#create-subaccount
 type SubAccount struct {
-	ID                  int             `json:"id,omitempty"`
-	CreatedAt           string          `json:"createdAt,omitempty"`
-	UpdatedAt           string          `json:"updatedAt,omitempty"`
-	Domain              string          `json:"domain,omitempty"`
-	Integration         int             `json:"integration,omitempty"`
-	BusinessName        string          `json:"business_name,omitempty"`
-	SubAccountCode      string          `json:"subaccount_code,omitempty"`
-	Description         string          `json:"description,omitempty"`
-	PrimaryContactName  string          `json:"primary_contact_name,omitempty"`
-	PrimaryContactEmail string          `json:"primary_contact_email,omitempty"`
-	PrimaryContactPhone string          `json:"primary_contact_phone,omitempty"`
-	Metadata            client.Metadata `json:"metadata,omitempty"`
-	PercentageCharge    float32         `json:"percentage_charge,omitempty"`
-	IsVerified          bool            `json:"is_verified,omitempty"`
-	SettlementBank      string          `json:"settlement_bank,omitempty"`
-	AccountNumber       string          `json:"account_number,omitempty"`
-	SettlementSchedule  string          `json:"settlement_schedule,omitempty"`
-	Active              bool            `json:"active,omitempty"`
-	Migrate             bool            `json:"migrate,omitempty"`
+	ID                  int                `json:"id,omitempty"`
+	CreatedAt           string             `json:"createdAt,omitempty"`
+	UpdatedAt           string             `json:"updatedAt,omitempty"`
+	Domain              string             `json:"domain,omitempty"`
+	Integration         int                `json:"integration,omitempty"`
+	BusinessName        string             `json:"business_name,omitempty"`
+	SubAccountCode      string             `json:"subaccount_code,omitempty"`
+	Description         string             `json:"description,omitempty"`
+	PrimaryContactName  string             `json:"primary_contact_name,omitempty"`
+	PrimaryContactEmail string             `json:"primary_contact_email,omitempty"`
+	PrimaryContactPhone string             `json:"primary_contact_phone,omitempty"`
+	Metadata            client.Metadata    `json:"metadata,omitempty"`
+	PercentageCharge    float32            `json:"percentage_charge,omitempty"`
+	IsVerified          bool               `json:"is_verified,omitempty"`
+	SettlementBank      string             `json:"settlement_bank,omitempty"`
+	AccountNumber       string             `json:"account_number,omitempty"`
+	SettlementSchedule  SettlementSchedule `json:"settlement_schedule,omitempty"`
+	Active              bool               `json:"active,omitempty"`
+	Migrate             bool               `json:"migrate,omitempty"`
+}
+
+// ListOptions filters the subaccounts returned by ListWithOptions
+type ListOptions struct {
+	// Active filters by status when set
+	Active *bool
+	// From and To limit the creation date range, e.g. 2016-09-21T00:00:00.000Z
+	From    string
+	To      string
+	PerPage int
+	Page    int
+}
+
+// normalise lower-cases the settlement schedule Paystack returns
+func (s *SubAccount) normalise() {
+	s.SettlementSchedule = SettlementSchedule(strings.ToLower(string(s.SettlementSchedule)))
 }
 
 // SubAccountList is a list object for subaccounts.
@@ -34,3 +65,10 @@ type SubAccountList struct {
 	Meta   response.ListMeta
 	Values []SubAccount `json:"data"`
 }
+
+// normalise lower-cases the settlement schedules Paystack returns
+func (l *SubAccountList) normalise() {
+	for i := range l.Values {
+		l.Values[i].normalise()
+	}
+}
@@ -2,52 +2,81 @@ package subaccount
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/hub1989/paystack-api-wrapper/bank"
 	"github.com/hub1989/paystack-api-wrapper/client"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
+// ErrNoIDOrCode is returned by Update for a subaccount with neither an ID nor a subaccount code
+var ErrNoIDOrCode = errors.New("subaccount: id or subaccount code is required")
+
 type Service interface {
 	Create(ctx context.Context, subaccount *SubAccount) (*SubAccount, error)
 	Update(ctx context.Context, subaccount *SubAccount) (*SubAccount, error)
-	Get(ctx context.Context, id int) (*SubAccount, error)
+	Get(ctx context.Context, idCode string) (*SubAccount, error)
 	List(ctx context.Context) (*SubAccountList, error)
 	ListN(ctx context.Context, count, offset int) (*SubAccountList, error)
+	ListWithOptions(ctx context.Context, opts *ListOptions) (*SubAccountList, error)
 }
 
 // DefaultSubAccountService handles operations related to subaccounts
 // For more details see https://developers.paystack.co/v1.0/reference#create-subaccount
 type DefaultSubAccountService struct {
 	*client.Client
+	// Banks, when set, is used to resolve the settlement account before a subaccount is created or updated,
+	// so a bad bank code or account number is reported before the subaccount call.
+	Banks bank.Service
 }
 
 // Create creates a new subaccount
 // For more details see https://paystack.com/docs/api/#subaccount-create
 func (s *DefaultSubAccountService) Create(ctx context.Context, subaccount *SubAccount) (*SubAccount, error) {
+	if err := s.resolveAccount(ctx, subaccount); err != nil {
+		return nil, err
+	}
+
 	u := fmt.Sprintf("/subaccount")
 	acc := &SubAccount{}
 	err := s.Client.Call(ctx, http.MethodPost, u, subaccount, acc)
+	acc.normalise()
 	return acc, err
 }
 
 // Update updates a subaccount's properties.
+// The subaccount is identified by its ID, or its subaccount code when the ID is not set.
 // For more details see https://developers.paystack.co/v1.0/reference#update-subaccount
-// TODO: use ID or slug
 func (s *DefaultSubAccountService) Update(ctx context.Context, subaccount *SubAccount) (*SubAccount, error) {
-	u := fmt.Sprintf("subaccount/%d", subaccount.ID)
+	idCode := subaccount.SubAccountCode
+	if subaccount.ID != 0 {
+		idCode = strconv.Itoa(subaccount.ID)
+	}
+	if idCode == "" {
+		return nil, ErrNoIDOrCode
+	}
+
+	if err := s.resolveAccount(ctx, subaccount); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("/subaccount/%s", idCode)
 	acc := &SubAccount{}
 	err := s.Client.Call(ctx, http.MethodPut, u, subaccount, acc)
+	acc.normalise()
 
 	return acc, err
 }
 
-// Get returns the details of a subaccount.
+// Get returns the details of a subaccount, given its ID or subaccount code.
 // For more details see https://developers.paystack.co/v1.0/reference#fetch-subaccount
-// TODO: use ID or slug
-func (s *DefaultSubAccountService) Get(ctx context.Context, id int) (*SubAccount, error) {
-	u := fmt.Sprintf("/subaccount/%d", id)
+func (s *DefaultSubAccountService) Get(ctx context.Context, idCode string) (*SubAccount, error) {
+	u := fmt.Sprintf("/subaccount/%s", idCode)
 	acc := &SubAccount{}
 	err := s.Client.Call(ctx, http.MethodGet, u, nil, acc)
+	acc.normalise()
 
 	return acc, err
 }
@@ -64,5 +93,42 @@ func (s *DefaultSubAccountService) ListN(ctx context.Context, count, offset int)
 	u := client.PaginateURL("/subaccount", count, offset)
 	acc := &SubAccountList{}
 	err := s.Client.Call(ctx, http.MethodGet, u, nil, acc)
+	acc.normalise()
+	return acc, err
+}
+
+// ListWithOptions returns the subaccounts matching opts
+// For more details see https://paystack.com/docs/api/subaccount/#list
+func (s *DefaultSubAccountService) ListWithOptions(ctx context.Context, opts *ListOptions) (*SubAccountList, error) {
+	params := url.Values{}
+	if opts != nil {
+		if opts.Active != nil {
+			params.Set("active", strconv.FormatBool(*opts.Active))
+		}
+		params.Set("from", opts.From)
+		params.Set("to", opts.To)
+		if opts.PerPage > 0 {
+			params.Set("perPage", strconv.Itoa(opts.PerPage))
+		}
+		if opts.Page > 0 {
+			params.Set("page", strconv.Itoa(opts.Page))
+		}
+	}
+
+	acc := &SubAccountList{}
+	err := s.Client.Call(ctx, http.MethodGet, client.AddQuery("/subaccount", params), nil, acc)
+	acc.normalise()
 	return acc, err
 }
+
+// resolveAccount checks the settlement account with Banks, when it is set and the account is being changed
+func (s *DefaultSubAccountService) resolveAccount(ctx context.Context, subaccount *SubAccount) error {
+	if s.Banks == nil || subaccount.AccountNumber == "" || subaccount.SettlementBank == "" {
+		return nil
+	}
+
+	if _, err := s.Banks.ResolveAccountNumber(ctx, subaccount.AccountNumber, subaccount.SettlementBank); err != nil {
+		return fmt.Errorf("subaccount: cannot resolve account %s at bank %s: %w", subaccount.AccountNumber, subaccount.SettlementBank, err)
+	}
+	return nil
+}
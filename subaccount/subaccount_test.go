@@ -1,51 +1,129 @@
 package subaccount
 
-//import (
-//	"github.com/hub1989/paystack-api-wrapper/client"
-//	"github.com/hub1989/paystack-api-wrapper/configuration"
-//	"testing"
-//)
-//
-//var c *client.Client
-//var service DefaultSubAccountService
-//
-//func init() {
-//	apiKey := client.MustGetTestKey()
-//	c = configuration.NewClient(apiKey, nil)
-//	service = DefaultSubAccountService{Client: c}
-//}
-//
-//func TestSubAccountCRUD(t *testing.T) {
-//	subAccount1 := &SubAccount{
-//		BusinessName:     "Sunshine Studios",
-//		SettlementBank:   "044",
-//		AccountNumber:    "0193278965",
-//		PercentageCharge: 18.2,
-//	}
-//
-//	// create the subAccount
-//	subAccount, err := service.Create(subAccount1)
-//	if err != nil {
-//		t.Errorf("CREATE SubAccount returned error: %v", err)
-//	}
-//
-//	if subAccount.SubAccountCode == "" {
-//		t.Errorf("Expected SubAccount code to be set")
-//	}
-//
-//	// retrieve the subAccount
-//	subAccount, err = service.Get(subAccount.ID)
-//	if err != nil {
-//		t.Errorf("GET SubAccount returned error: %v", err)
-//	}
-//
-//	if subAccount.BusinessName != subAccount1.BusinessName {
-//		t.Errorf("Expected SubAccount BusinessName %v, got %v", subAccount.BusinessName, subAccount1.BusinessName)
-//	}
-//
-//	// retrieve the subAccount list
-//	subAccounts, err := service.List()
-//	if err != nil || !(len(subAccounts.Values) > 0) || !(subAccounts.Meta.Total > 0) {
-//		t.Errorf("Expected SubAccount list, got %d, returned error %v", len(subAccounts.Values), err)
-//	}
-//}
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/hub1989/paystack-api-wrapper/bank"
+	"github.com/hub1989/paystack-api-wrapper/configuration"
+	"github.com/hub1989/paystack-api-wrapper/response"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+type fakeBanks struct {
+	bank.Service
+	resolved []string
+}
+
+var errUnresolved = errors.New("could not resolve account name")
+
+func (f *fakeBanks) ResolveAccountNumber(ctx context.Context, accountNumber, bankCode string) (response.Response, error) {
+	f.resolved = append(f.resolved, bankCode+"/"+accountNumber)
+	return nil, errUnresolved
+}
+
+func TestCreateValidatesSettlementAccount(t *testing.T) {
+	banks := &fakeBanks{}
+	service := &DefaultSubAccountService{Banks: banks}
+
+	_, err := service.Create(context.TODO(), &SubAccount{
+		BusinessName:       "Sunshine Studios",
+		SettlementBank:     "044",
+		AccountNumber:      "0193278966",
+		PercentageCharge:   18.2,
+		SettlementSchedule: ScheduleWeekly,
+	})
+	if !errors.Is(err, errUnresolved) {
+		t.Errorf("Expected the account resolution error, got %v", err)
+	}
+
+	if len(banks.resolved) != 1 || banks.resolved[0] != "044/0193278966" {
+		t.Errorf("Expected the settlement account to be resolved, got %v", banks.resolved)
+	}
+}
+
+func newService(t *testing.T, handler http.HandlerFunc) *DefaultSubAccountService {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c := configuration.NewClient("sk_test", server.Client(), false)
+	c.BaseURL, _ = url.Parse(server.URL)
+	return &DefaultSubAccountService{Client: c}
+}
+
+func TestUpdateUsesIDOrCode(t *testing.T) {
+	var paths []string
+	service := newService(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": true,
+			"data":   map[string]interface{}{"id": 55, "subaccount_code": "ACCT_6uujpqtzmnufzkw"},
+		})
+	})
+
+	updates := []*SubAccount{
+		{ID: 55, SubAccountCode: "ACCT_6uujpqtzmnufzkw", BusinessName: "by id"},
+		{SubAccountCode: "ACCT_6uujpqtzmnufzkw", BusinessName: "by code"},
+	}
+	for _, sub := range updates {
+		if _, err := service.Update(context.TODO(), sub); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(paths) != 2 || paths[0] != "PUT /subaccount/55" || paths[1] != "PUT /subaccount/ACCT_6uujpqtzmnufzkw" {
+		t.Errorf("Expected updates by id then code, got %v", paths)
+	}
+
+	if _, err := service.Update(context.TODO(), &SubAccount{BusinessName: "nameless"}); !errors.Is(err, ErrNoIDOrCode) {
+		t.Errorf("Expected ErrNoIDOrCode, got %v", err)
+	}
+	if len(paths) != 2 {
+		t.Errorf("Expected no request without an id or code, got %v", paths)
+	}
+}
+
+func TestListWithOptions(t *testing.T) {
+	var query url.Values
+	service := newService(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": true,
+			"data": []interface{}{
+				map[string]interface{}{"id": 55, "subaccount_code": "ACCT_6uujpqtzmnufzkw", "settlement_schedule": "WEEKLY"},
+			},
+			"meta": map[string]interface{}{"total": 1, "perPage": 20, "page": 2},
+		})
+	})
+
+	active := false
+	list, err := service.ListWithOptions(context.TODO(), &ListOptions{Active: &active, From: "2023-01-01", To: "2023-02-01", PerPage: 20, Page: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := url.Values{"active": {"false"}, "from": {"2023-01-01"}, "to": {"2023-02-01"}, "perPage": {"20"}, "page": {"2"}}
+	if query.Encode() != want.Encode() {
+		t.Errorf("Expected query %v, got %v", want, query)
+	}
+
+	if len(list.Values) != 1 || list.Values[0].SubAccountCode != "ACCT_6uujpqtzmnufzkw" || list.Meta.Page != 2 {
+		t.Errorf("Unexpected subaccount list %+v", list)
+	}
+
+	// Paystack returns the schedule in upper case
+	if list.Values[0].SettlementSchedule != ScheduleWeekly {
+		t.Errorf("Expected the weekly schedule, got %q", list.Values[0].SettlementSchedule)
+	}
+
+	// the filter is left out when it is not set
+	if _, err := service.ListWithOptions(context.TODO(), &ListOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if query.Has("active") {
+		t.Errorf("Expected no active filter, got %v", query)
+	}
+}
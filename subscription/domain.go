@@ -2,6 +2,19 @@ package subscription
 
 import "github.com/hub1989/paystack-api-wrapper/response"
 
+// Status is the state of a subscription
+type Status string
+
+const (
+	StatusActive Status = "active"
+	// StatusNonRenewing subscriptions are cancelled at the end of the current period
+	StatusNonRenewing Status = "non-renewing"
+	// StatusAttention subscriptions have a failed renewal
+	StatusAttention Status = "attention"
+	StatusCompleted Status = "completed"
+	StatusCancelled Status = "cancelled"
+)
+
 // Subscription represents a Paystack subscription
 // For more details see https://developers.paystack.co/v1.0/reference#create-subscription
 type Subscription struct {
@@ -11,21 +24,40 @@ type Subscription struct {
 	Domain      string `json:"domain,omitempty"`
 	Integration int    `json:"integration,omitempty"`
 	// inconsistent API response. Create returns Customer code, Fetch returns an object
-	Customer  interface{} `json:"customer,omitempty"`
-	Plan      string      `json:"plan,omitempty"`
-	StartDate string      `json:"start,omitempty"`
+	Customer interface{} `json:"customer,omitempty"`
+	// Plan is the plan ID. Fetch and List return the plan object, which is decoded to its ID;
+	// the expand package loads the plan itself.
+	Plan      response.Reference `json:"plan,omitempty"`
+	StartDate string             `json:"start,omitempty"`
 	// inconsistent API response. Fetch returns string, List returns an object
-	Authorization    interface{}   `json:"authorization,omitempty"`
-	Invoices         []interface{} `json:"invoices,omitempty"`
-	Status           string        `json:"status,omitempty"`
-	Quantity         int           `json:"quantity,omitempty"`
-	Amount           int           `json:"amount,omitempty"`
-	SubscriptionCode string        `json:"subscription_code,omitempty"`
-	EmailToken       string        `json:"email_token,omitempty"`
-	EasyCronID       string        `json:"easy_cron_id,omitempty"`
-	CronExpression   string        `json:"cron_expression,omitempty"`
-	NextPaymentDate  string        `json:"next_payment_date,omitempty"`
-	OpenInvoice      string        `json:"open_invoice,omitempty"`
+	Authorization    interface{} `json:"authorization,omitempty"`
+	Invoices         []Invoice   `json:"invoices,omitempty"`
+	Status           Status      `json:"status,omitempty"`
+	Quantity         int         `json:"quantity,omitempty"`
+	Amount           int         `json:"amount,omitempty"`
+	SubscriptionCode string      `json:"subscription_code,omitempty"`
+	EmailToken       string      `json:"email_token,omitempty"`
+	EasyCronID       string      `json:"easy_cron_id,omitempty"`
+	CronExpression   string      `json:"cron_expression,omitempty"`
+	NextPaymentDate  string      `json:"next_payment_date,omitempty"`
+	OpenInvoice      string      `json:"open_invoice,omitempty"`
+}
+
+// Invoice is a charge of a subscription for one billing period
+type Invoice struct {
+	ID          int    `json:"id,omitempty"`
+	Domain      string `json:"domain,omitempty"`
+	InvoiceCode string `json:"invoice_code,omitempty"`
+	Amount      int    `json:"amount,omitempty"`
+	PeriodStart string `json:"period_start,omitempty"`
+	PeriodEnd   string `json:"period_end,omitempty"`
+	Status      string `json:"status,omitempty"`
+	Paid        bool   `json:"paid,omitempty"`
+	PaidAt      string `json:"paid_at,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Transaction is the ID of the transaction that paid the invoice
+	Transaction int    `json:"transaction,omitempty"`
+	CreatedAt   string `json:"createdAt,omitempty"`
 }
 
 // Request represents a Paystack subscription request
@@ -38,6 +70,16 @@ type Request struct {
 	StartDate     string `json:"start,omitempty"`
 }
 
+// ListOptions filters the subscriptions returned by ListWithOptions
+type ListOptions struct {
+	// Customer is the customer ID
+	Customer string
+	// Plan is the plan ID
+	Plan    string
+	PerPage int
+	Page    int
+}
+
 // List is a list object for subscriptions.
 type List struct {
 	Meta   response.ListMeta
@@ -7,16 +7,19 @@ import (
 	"github.com/hub1989/paystack-api-wrapper/response"
 	"net/http"
 	"net/url"
+	"strconv"
 )
 
 type Service interface {
 	Create(ctx context.Context, subscription *Request) (*Subscription, error)
-	Update(ctx context.Context, subscription *Subscription) (*Subscription, error)
-	Get(ctx context.Context, id int) (*Subscription, error)
+	Get(ctx context.Context, idCode string) (*Subscription, error)
 	List(ctx context.Context) (*List, error)
 	ListN(ctx context.Context, count, offset int) (*List, error)
+	ListWithOptions(ctx context.Context, opts *ListOptions) (*List, error)
 	Enable(ctx context.Context, subscriptionCode, emailToken string) (response.Response, error)
 	Disable(ctx context.Context, subscriptionCode, emailToken string) (response.Response, error)
+	GenerateUpdateLink(ctx context.Context, subscriptionCode string) (string, error)
+	SendUpdateLink(ctx context.Context, subscriptionCode string) (response.Response, error)
 }
 
 // DefaultSubscriptionService handles operations related to the subscription
@@ -34,19 +37,10 @@ func (s *DefaultSubscriptionService) Create(ctx context.Context, subscription *R
 	return sub, err
 }
 
-// Update updates a subscription's properties.
-// For more details see https://developers.paystack.co/v1.0/reference#update-subscription
-func (s *DefaultSubscriptionService) Update(ctx context.Context, subscription *Subscription) (*Subscription, error) {
-	u := fmt.Sprintf("subscription/%d", subscription.ID)
-	sub := &Subscription{}
-	err := s.Client.Call(ctx, http.MethodPut, u, subscription, sub)
-	return sub, err
-}
-
-// Get returns the details of a subscription.
+// Get returns the details of a subscription, given its ID or subscription code.
 // For more details see https://developers.paystack.co/v1.0/reference#fetch-subscription
-func (s *DefaultSubscriptionService) Get(ctx context.Context, id int) (*Subscription, error) {
-	u := fmt.Sprintf("/subscription/%d", id)
+func (s *DefaultSubscriptionService) Get(ctx context.Context, idCode string) (*Subscription, error) {
+	u := fmt.Sprintf("/subscription/%s", idCode)
 	sub := &Subscription{}
 	err := s.Client.Call(ctx, http.MethodGet, u, nil, sub)
 	return sub, err
@@ -67,6 +61,26 @@ func (s *DefaultSubscriptionService) ListN(ctx context.Context, count, offset in
 	return sub, err
 }
 
+// ListWithOptions returns the subscriptions matching opts
+// For more details see https://paystack.com/docs/api/subscription/#list
+func (s *DefaultSubscriptionService) ListWithOptions(ctx context.Context, opts *ListOptions) (*List, error) {
+	params := url.Values{}
+	if opts != nil {
+		params.Set("customer", opts.Customer)
+		params.Set("plan", opts.Plan)
+		if opts.PerPage > 0 {
+			params.Set("perPage", strconv.Itoa(opts.PerPage))
+		}
+		if opts.Page > 0 {
+			params.Set("page", strconv.Itoa(opts.Page))
+		}
+	}
+
+	sub := &List{}
+	err := s.Client.Call(ctx, http.MethodGet, client.AddQuery("/subscription", params), nil, sub)
+	return sub, err
+}
+
 // Enable enables a subscription
 // For more details see https://developers.paystack.co/v1.0/reference#enable-subscription
 func (s *DefaultSubscriptionService) Enable(ctx context.Context, subscriptionCode, emailToken string) (response.Response, error) {
@@ -74,7 +88,7 @@ func (s *DefaultSubscriptionService) Enable(ctx context.Context, subscriptionCod
 	params.Add("code", subscriptionCode)
 	params.Add("token", emailToken)
 	resp := response.Response{}
-	err := s.Client.Call(ctx, http.MethodPost, "/subscription/enable", params, &resp)
+	err := s.Client.Call(ctx, http.MethodPost, "/subscription/enable", response.RequestValues(params), &resp)
 	return resp, err
 }
 
@@ -85,6 +99,26 @@ func (s *DefaultSubscriptionService) Disable(ctx context.Context, subscriptionCo
 	params.Add("code", subscriptionCode)
 	params.Add("token", emailToken)
 	resp := response.Response{}
-	err := s.Client.Call(ctx, http.MethodPost, "/subscription/disable", params, &resp)
+	err := s.Client.Call(ctx, http.MethodPost, "/subscription/disable", response.RequestValues(params), &resp)
+	return resp, err
+}
+
+// GenerateUpdateLink returns a link the customer can use to update the card of a subscription
+// For more details see https://paystack.com/docs/api/subscription/#manage-link
+func (s *DefaultSubscriptionService) GenerateUpdateLink(ctx context.Context, subscriptionCode string) (string, error) {
+	u := fmt.Sprintf("/subscription/%s/manage/link", subscriptionCode)
+	link := &struct {
+		Link string `json:"link"`
+	}{}
+	err := s.Client.Call(ctx, http.MethodGet, u, nil, link)
+	return link.Link, err
+}
+
+// SendUpdateLink emails the customer a link to update the card of a subscription
+// For more details see https://paystack.com/docs/api/subscription/#manage-email
+func (s *DefaultSubscriptionService) SendUpdateLink(ctx context.Context, subscriptionCode string) (response.Response, error) {
+	u := fmt.Sprintf("/subscription/%s/manage/email", subscriptionCode)
+	resp := response.Response{}
+	err := s.Client.Call(ctx, http.MethodPost, u, nil, &resp)
 	return resp, err
 }
@@ -1,65 +1,55 @@
 package subscription
 
-//import (
-//	"github.com/hub1989/paystack-api-wrapper/client"
-//	"github.com/hub1989/paystack-api-wrapper/configuration"
-//	"github.com/hub1989/paystack-api-wrapper/customer"
-//	plan2 "github.com/hub1989/paystack-api-wrapper/plan"
-//	"testing"
-//)
-//
-//var c *client.Client
-//var service DefaultSubscriptionService
-//var customerService customer.DefaultCustomerService
-//var planService plan2.DefaultPlanService
-//
-//func init() {
-//	apiKey := client.MustGetTestKey()
-//	c = configuration.NewClient(apiKey, nil)
-//	service = DefaultSubscriptionService{Client: c}
-//	customerService = customer.DefaultCustomerService{Client: c}
-//	planService = plan2.DefaultPlanService{Client: c}
-//}
-//
-//func TestSubscriptionCRUD(t *testing.T) {
-//	cust := &customer.Customer{
-//		FirstName: "User123",
-//		LastName:  "AdminUser",
-//		Email:     "user123-subscription@gmail.com",
-//		Phone:     "+23400000000000000",
-//	}
-//	// create the customer
-//	create, err := customerService.Create(cust)
-//	if err != nil {
-//		t.Errorf("CREATE Subscription Customer returned error: %v", err)
-//	}
-//
-//	plan1 := &plan2.Plan{
-//		Name:     "Monthly subscription retainer",
-//		Interval: "monthly",
-//		Amount:   250000,
-//	}
-//
-//	// create the plan
-//	plan, err := planService.Create(plan1)
-//	if err != nil {
-//		t.Errorf("CREATE Plan returned error: %v", err)
-//	}
-//
-//	subscription1 := &Request{
-//		Customer: create.CustomerCode,
-//		Plan:     plan.PlanCode,
-//	}
-//
-//	// create the subscription
-//	_, err = service.Create(subscription1)
-//	if err == nil {
-//		t.Errorf("Expected CREATE Subscription to fail with aunthorized create, got %+v", err)
-//	}
-//
-//	// retrieve the subscription list
-//	subscriptions, err := service.List()
-//	if err != nil {
-//		t.Errorf("Expected Subscription list, got %d, returned error %v", len(subscriptions.Values), err)
-//	}
-//}
+import (
+	"context"
+	"encoding/json"
+	"github.com/hub1989/paystack-api-wrapper/configuration"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestSubscriptionManageLinkAndInvoices(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data map[string]interface{}
+		switch r.Method + " " + r.URL.Path {
+		case "GET /subscription/SUB_vsyqdmlzble3uii/manage/link":
+			data = map[string]interface{}{"link": "https://paystack.com/manage/subscriptions/qlgwhpyq1ts9nsw?subscription_token=uqt9cyrfq2wv7kj"}
+		case "GET /subscription/SUB_vsyqdmlzble3uii":
+			data = map[string]interface{}{
+				"subscription_code": "SUB_vsyqdmlzble3uii",
+				"status":            "attention",
+				"invoices": []interface{}{
+					map[string]interface{}{"invoice_code": "INV_1", "amount": 50000, "paid": false, "status": "failed"},
+				},
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": false, "message": "not found"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": true, "data": data})
+	}))
+	defer server.Close()
+
+	c := configuration.NewClient("sk_test", server.Client(), false)
+	c.BaseURL, _ = url.Parse(server.URL)
+	service := &DefaultSubscriptionService{Client: c}
+
+	link, err := service.GenerateUpdateLink(context.TODO(), "SUB_vsyqdmlzble3uii")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if link != "https://paystack.com/manage/subscriptions/qlgwhpyq1ts9nsw?subscription_token=uqt9cyrfq2wv7kj" {
+		t.Errorf("Unexpected manage link %q", link)
+	}
+
+	sub, err := service.Get(context.TODO(), "SUB_vsyqdmlzble3uii")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sub.Status != StatusAttention || len(sub.Invoices) != 1 || sub.Invoices[0].InvoiceCode != "INV_1" {
+		t.Errorf("Expected a subscription needing attention with one invoice, got %+v", sub)
+	}
+}
@@ -13,6 +13,18 @@ type List struct {
 	Values []Transaction `json:"data"`
 }
 
+// ListOptions filters the transactions returned by ListWithOptions
+type ListOptions struct {
+	// Status is one of success, failed or abandoned
+	Status   string
+	Customer string
+	// From and To limit the creation date range, e.g. 2016-09-21T00:00:00.000Z
+	From    string
+	To      string
+	PerPage int
+	Page    int
+}
+
 // Request represents a request to start a transaction.
 type Request struct {
 	CallbackURL       string          `json:"callback_url,omitempty"`
@@ -27,6 +39,7 @@ type Request struct {
 	SubAccount        string          `json:"subaccount,omitempty"`
 	TransactionCharge int             `json:"transaction_charge,omitempty"`
 	Bearer            string          `json:"bearer,omitempty"`
+	SplitCode         string          `json:"split_code,omitempty"`
 	Channels          []string        `json:"channels,omitempty"`
 }
 
@@ -43,26 +56,27 @@ type AuthorizationRequest struct {
 // Transaction is the resource representing your Paystack transaction.
 // For more details see https://developers.paystack.co/v1.0/reference#initialize-a-transaction
 type Transaction struct {
-	ID              int                   `json:"id,omitempty"`
-	CreatedAt       string                `json:"createdAt,omitempty"`
-	Domain          string                `json:"domain,omitempty"`
-	Metadata        interface{}           `json:"metadata,omitempty"` //TODO: why is transaction metadata a string?
-	Status          string                `json:"status,omitempty"`
-	Reference       string                `json:"reference,omitempty"`
-	Amount          float32               `json:"amount,omitempty"`
+	ID        int         `json:"id,omitempty"`
+	CreatedAt string      `json:"createdAt,omitempty"`
+	Domain    string      `json:"domain,omitempty"`
+	Metadata  interface{} `json:"metadata,omitempty"` //TODO: why is transaction metadata a string?
+	Status    string      `json:"status,omitempty"`
+	Reference string      `json:"reference,omitempty"`
+	// Amount is in the currency's subunit
+	Amount          int64                 `json:"amount,omitempty"`
 	Message         string                `json:"message,omitempty"`
 	GatewayResponse string                `json:"gateway_response,omitempty"`
-	PaidAt          string                `json:"piad_at,omitempty"`
+	PaidAt          string                `json:"paid_at,omitempty"`
 	Channel         string                `json:"channel,omitempty"`
 	Currency        string                `json:"currency,omitempty"`
 	IPAddress       string                `json:"ip_address,omitempty"`
 	Log             Log                   `json:"log,omitempty"` // TODO: same as timeline?
-	Fees            int                   `json:"int,omitempty"`
+	Fees            int64                 `json:"fees,omitempty"`
 	FeesSplit       string                `json:"fees_split,omitempty"` // TODO: confirm data type
 	Customer        Customer              `json:"customer,omitempty"`
 	Authorization   Authorization         `json:"authorization,omitempty"`
 	Plan            plan.Plan             `json:"plan,omitempty"`
-	SubAccount      subaccount.SubAccount `json:"sub_account,omitempty"`
+	SubAccount      subaccount.SubAccount `json:"subaccount,omitempty"`
 }
 
 // Authorization represents Paystack authorization object
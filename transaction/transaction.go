@@ -6,6 +6,8 @@ import (
 	"github.com/hub1989/paystack-api-wrapper/client"
 	"github.com/hub1989/paystack-api-wrapper/response"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
 type Service interface {
@@ -14,6 +16,7 @@ type Service interface {
 	List(ctx context.Context) (*List, error)
 	ListForCustomer(ctx context.Context, customerId string) (*List, error)
 	ListN(ctx context.Context, count, offset int) (*List, error)
+	ListWithOptions(ctx context.Context, opts *ListOptions) (*List, error)
 	Get(ctx context.Context, id int) (*Transaction, error)
 	ChargeAuthorization(ctx context.Context, req *Request) (*Transaction, error)
 	Timeline(ctx context.Context, reference string) (*Timeline, error)
@@ -62,6 +65,28 @@ func (s *DefaultTransactionService) ListN(ctx context.Context, count, offset int
 	return txns, err
 }
 
+// ListWithOptions returns the transactions matching opts
+// For more details see https://paystack.com/docs/api/transaction/#list
+func (s *DefaultTransactionService) ListWithOptions(ctx context.Context, opts *ListOptions) (*List, error) {
+	params := url.Values{}
+	if opts != nil {
+		params.Set("status", opts.Status)
+		params.Set("customer", opts.Customer)
+		params.Set("from", opts.From)
+		params.Set("to", opts.To)
+		if opts.PerPage > 0 {
+			params.Set("perPage", strconv.Itoa(opts.PerPage))
+		}
+		if opts.Page > 0 {
+			params.Set("page", strconv.Itoa(opts.Page))
+		}
+	}
+
+	txns := &List{}
+	err := s.Client.Call(ctx, http.MethodGet, client.AddQuery("/transaction", params), nil, txns)
+	return txns, err
+}
+
 // Get returns the details of a transaction.
 // For more details see https://developers.paystack.co/v1.0/reference#fetch-transaction
 func (s *DefaultTransactionService) Get(ctx context.Context, id int) (*Transaction, error) {
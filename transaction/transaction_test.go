@@ -51,7 +51,7 @@ func TestInitializeTransaction(t *testing.T) {
 		t.Error(err)
 	}
 
-	if txn1.Amount != txn.Amount {
+	if float32(txn1.Amount) != txn.Amount {
 		t.Errorf("Expected transaction amount %f, got %+v", txn.Amount, txn1.Amount)
 	}
 
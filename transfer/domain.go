@@ -1,6 +1,7 @@
 package transfer
 
 import (
+	"fmt"
 	"github.com/hub1989/paystack-api-wrapper/client"
 	"github.com/hub1989/paystack-api-wrapper/response"
 )
@@ -11,6 +12,33 @@ type Request struct {
 	Currency  string  `json:"currency,omitempty"`
 	Reason    string  `json:"reason,omitempty"`
 	Recipient string  `json:"recipient,omitempty"`
+	// Reference identifies the transfer in your system and can be used to Verify it.
+	// It must be 16 to 50 lowercase letters, digits, dashes or underscores.
+	Reference string `json:"reference,omitempty"`
+}
+
+// Status is the state of a transfer
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusOTP       Status = "otp"
+	StatusSuccess   Status = "success"
+	StatusFailed    Status = "failed"
+	StatusReversed  Status = "reversed"
+	StatusAbandoned Status = "abandoned"
+	StatusBlocked   Status = "blocked"
+	StatusRejected  Status = "rejected"
+	StatusReceived  Status = "received"
+)
+
+// Terminal reports whether the transfer can no longer change state
+func (s Status) Terminal() bool {
+	switch s {
+	case StatusSuccess, StatusFailed, StatusReversed, StatusAbandoned, StatusBlocked, StatusRejected:
+		return true
+	}
+	return false
 }
 
 // Transfer is the resource representing your Paystack transfer.
@@ -26,9 +54,10 @@ type Transfer struct {
 	Currency     string  `json:"currency,omitempty"`
 	Reason       string  `json:"reason,omitempty"`
 	TransferCode string  `json:"transfer_code,omitempty"`
+	Reference    string  `json:"reference,omitempty"`
 	// Initiate returns recipient ID as recipient value, Fetch returns recipient object
 	Recipient interface{} `json:"recipient,omitempty"`
-	Status    string      `json:"status,omitempty"`
+	Status    Status      `json:"status,omitempty"`
 	// confirm types for source_details and failures
 	SourceDetails interface{} `json:"source_details,omitempty"`
 	Failures      interface{} `json:"failures,omitempty"`
@@ -36,31 +65,82 @@ type Transfer struct {
 	TitanCode     string      `json:"titan_code,omitempty"`
 }
 
+// RecipientType is the kind of account a transfer recipient is paid into
+type RecipientType string
+
+const (
+	RecipientTypeNUBAN         RecipientType = "nuban"
+	RecipientTypeMobileMoney   RecipientType = "mobile_money"
+	RecipientTypeBASA          RecipientType = "basa"
+	RecipientTypeAuthorization RecipientType = "authorization"
+)
+
 // Recipient represents a Paystack transfer recipient
 // For more details see https://developers.paystack.co/v1.0/reference#create-transfer-recipient
 type Recipient struct {
-	ID            int                    `json:"id,omitempty"`
-	CreatedAt     string                 `json:"createdAt,omitempty"`
-	UpdatedAt     string                 `json:"updatedAt,omitempty"`
-	Type          string                 `json:",omitempty"`
-	Name          string                 `json:"name,omitempty"`
-	Metadata      client.Metadata        `json:"metadata,omitempty"`
-	AccountNumber string                 `json:"account_number,omitempty"`
-	BankCode      string                 `json:"bank_code,omitempty"`
-	Currency      string                 `json:"currency,omitempty"`
-	Description   string                 `json:"description,omitempty"`
-	Active        bool                   `json:"active,omitempty"`
-	Details       map[string]interface{} `json:"details,omitempty"`
-	Domain        string                 `json:"domain,omitempty"`
-	RecipientCode string                 `json:"recipient_code,omitempty"`
+	ID            int             `json:"id,omitempty"`
+	CreatedAt     string          `json:"createdAt,omitempty"`
+	UpdatedAt     string          `json:"updatedAt,omitempty"`
+	Type          RecipientType   `json:"type,omitempty"`
+	Name          string          `json:"name,omitempty"`
+	Email         string          `json:"email,omitempty"`
+	Metadata      client.Metadata `json:"metadata,omitempty"`
+	AccountNumber string          `json:"account_number,omitempty"`
+	BankCode      string          `json:"bank_code,omitempty"`
+	Currency      string          `json:"currency,omitempty"`
+	Description   string          `json:"description,omitempty"`
+	// AuthorizationCode is required for authorization recipients
+	AuthorizationCode string                 `json:"authorization_code,omitempty"`
+	Active            bool                   `json:"active,omitempty"`
+	Details           map[string]interface{} `json:"details,omitempty"`
+	Domain            string                 `json:"domain,omitempty"`
+	RecipientCode     string                 `json:"recipient_code,omitempty"`
+}
+
+// BulkRecipientResult is the outcome of BulkCreateRecipients.
+// Recipients that could not be created are listed in Errors with the payload that was sent.
+type BulkRecipientResult struct {
+	Success []Recipient          `json:"success"`
+	Errors  []BulkRecipientError `json:"errors"`
+}
+
+// BulkRecipientError is a recipient rejected by BulkCreateRecipients
+type BulkRecipientError struct {
+	Error   string    `json:"error"`
+	Payload Recipient `json:"payload"`
 }
 
 // BulkTransfer represents a Paystack bulk transfer
 // You need to disable the Transfers OTP requirement to use this endpoint
 type BulkTransfer struct {
-	Currency  string                   `json:"currency,omitempty"`
-	Source    string                   `json:"source,omitempty"`
-	Transfers []map[string]interface{} `json:"transfers,omitempty"`
+	Currency  string             `json:"currency,omitempty"`
+	Source    string             `json:"source,omitempty"`
+	Transfers []BulkTransferItem `json:"transfers,omitempty"`
+}
+
+// BulkTransferItem is a single transfer of a bulk transfer
+type BulkTransferItem struct {
+	Amount float32 `json:"amount"`
+	// Recipient is the recipient code
+	Recipient string `json:"recipient"`
+	// Reference identifies the item in the BulkTransferResult. It is generated when empty.
+	Reference string `json:"reference,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// BulkTransferResult holds the transfers queued by MakeBulkTransfer, keyed by item reference
+type BulkTransferResult struct {
+	Transfers map[string]Transfer
+}
+
+// BulkTransferError reports the items of a bulk transfer that were not queued, keyed by item reference.
+// Items of a chunk that failed in transit may still have been queued; Verify them by reference before retrying.
+type BulkTransferError struct {
+	Failed map[string]error
+}
+
+func (e *BulkTransferError) Error() string {
+	return fmt.Sprintf("transfer: %d bulk transfer items failed", len(e.Failed))
 }
 
 // List is a list object for transfers.
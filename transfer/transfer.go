@@ -2,6 +2,9 @@ package transfer
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"github.com/hub1989/paystack-api-wrapper/client"
 	"github.com/hub1989/paystack-api-wrapper/response"
@@ -9,11 +12,22 @@ import (
 	"net/url"
 )
 
+// MaxBulkTransferItems is the number of transfers Paystack accepts in a single bulk transfer request
+const MaxBulkTransferItems = 100
+
+var (
+	// ErrDuplicateReference is returned when two items of a bulk transfer share a reference
+	ErrDuplicateReference = errors.New("transfer: duplicate reference")
+	// ErrNoResult is recorded for a bulk transfer item that Paystack did not return a transfer for
+	ErrNoResult = errors.New("transfer: no transfer returned for item")
+)
+
 type Service interface {
 	Initiate(ctx context.Context, req *Request) (*Transfer, error)
 	Finalize(ctx context.Context, code, otp string) (response.Response, error)
-	MakeBulkTransfer(ctx context.Context, req *BulkTransfer) (response.Response, error)
+	MakeBulkTransfer(ctx context.Context, req *BulkTransfer) (*BulkTransferResult, error)
 	Get(ctx context.Context, idCode string) (*Transfer, error)
+	Verify(ctx context.Context, reference string) (*Transfer, error)
 	List(ctx context.Context) (*List, error)
 	ListN(ctx context.Context, count, offset int) (*List, error)
 	ResendOTP(ctx context.Context, transferCode, reason string) (response.Response, error)
@@ -22,6 +36,10 @@ type Service interface {
 	CreateRecipient(ctx context.Context, recipient *Recipient) (*Recipient, error)
 	ListRecipients(ctx context.Context) (*RecipientList, error)
 	ListRecipientsN(ctx context.Context, count, offset int) (*RecipientList, error)
+	GetRecipient(ctx context.Context, idCode string) (*Recipient, error)
+	UpdateRecipient(ctx context.Context, idCode, name, email string) (response.Response, error)
+	DeleteRecipient(ctx context.Context, idCode string) (response.Response, error)
+	BulkCreateRecipients(ctx context.Context, recipients []Recipient) (*BulkRecipientResult, error)
 	DisableOTP(ctx context.Context) (response.Response, error)
 }
 
@@ -29,13 +47,28 @@ type Service interface {
 // For more details see https://developers.paystack.co/v1.0/reference#create-transfer
 type DefaultTransferService struct {
 	*client.Client
+	// ReferenceGenerator, when set, is used by Initiate to fill in requests without a Reference.
+	// NewReference can be used as a generator.
+	ReferenceGenerator func() string
 }
 
-// TransferRequest represents a request to create a transfer.
+// NewReference returns a random transfer reference
+func NewReference() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
 
-// Initiate initiates a new transfer
+// Initiate initiates a new transfer.
+// If req has no Reference and a ReferenceGenerator is set, the generated reference is stored on req
+// so the transfer can be verified even if this call times out.
 // For more details see https://developers.paystack.co/v1.0/reference#initiate-transfer
 func (s *DefaultTransferService) Initiate(ctx context.Context, req *Request) (*Transfer, error) {
+	if req.Reference == "" && s.ReferenceGenerator != nil {
+		req.Reference = s.ReferenceGenerator()
+	}
 	transfer := &Transfer{}
 	err := s.Client.Call(ctx, http.MethodPost, "/transfer", req, transfer)
 	return transfer, err
@@ -49,18 +82,70 @@ func (s *DefaultTransferService) Finalize(ctx context.Context, code, otp string)
 	req.Add("transfer_code", code)
 	req.Add("otp", otp)
 	resp := response.Response{}
-	err := s.Client.Call(ctx, http.MethodPost, u, req, &resp)
+	err := s.Client.Call(ctx, http.MethodPost, u, response.RequestValues(req), &resp)
 	return resp, err
 }
 
 // MakeBulkTransfer initiates a new bulk transfer request
-// You need to disable the Transfers OTP requirement to use this endpoint
+// You need to disable the Transfers OTP requirement to use this endpoint.
+// Items without a reference get one from ReferenceGenerator, or NewReference, stored on req.
+// The items are sent in chunks of MaxBulkTransferItems; when some chunks fail, the queued transfers
+// are returned together with a *BulkTransferError listing the failed items.
 // For more details see https://developers.paystack.co/v1.0/reference#initiate-bulk-transfer
-func (s *DefaultTransferService) MakeBulkTransfer(ctx context.Context, req *BulkTransfer) (response.Response, error) {
-	u := fmt.Sprintf("/transfer")
-	resp := response.Response{}
-	err := s.Client.Call(ctx, http.MethodPost, u, req, &resp)
-	return resp, err
+func (s *DefaultTransferService) MakeBulkTransfer(ctx context.Context, req *BulkTransfer) (*BulkTransferResult, error) {
+	seen := make(map[string]bool, len(req.Transfers))
+	for i := range req.Transfers {
+		item := &req.Transfers[i]
+		if item.Reference == "" {
+			item.Reference = s.newReference()
+		}
+		if seen[item.Reference] {
+			return nil, fmt.Errorf("%w: %q", ErrDuplicateReference, item.Reference)
+		}
+		seen[item.Reference] = true
+	}
+
+	result := &BulkTransferResult{Transfers: make(map[string]Transfer, len(req.Transfers))}
+	failed := map[string]error{}
+
+	for start := 0; start < len(req.Transfers); start += MaxBulkTransferItems {
+		end := start + MaxBulkTransferItems
+		if end > len(req.Transfers) {
+			end = len(req.Transfers)
+		}
+		chunk := &BulkTransfer{Currency: req.Currency, Source: req.Source, Transfers: req.Transfers[start:end]}
+
+		transfers := &struct {
+			Values []Transfer `json:"data"`
+		}{}
+		if err := s.Client.Call(ctx, http.MethodPost, "/transfer/bulk", chunk, transfers); err != nil {
+			for _, item := range chunk.Transfers {
+				failed[item.Reference] = err
+			}
+			continue
+		}
+
+		for _, t := range transfers.Values {
+			result.Transfers[t.Reference] = t
+		}
+		for _, item := range chunk.Transfers {
+			if _, ok := result.Transfers[item.Reference]; !ok {
+				failed[item.Reference] = ErrNoResult
+			}
+		}
+	}
+
+	if len(failed) > 0 {
+		return result, &BulkTransferError{Failed: failed}
+	}
+	return result, nil
+}
+
+func (s *DefaultTransferService) newReference() string {
+	if s.ReferenceGenerator != nil {
+		return s.ReferenceGenerator()
+	}
+	return NewReference()
 }
 
 // Get returns the details of a transfer.
@@ -72,6 +157,15 @@ func (s *DefaultTransferService) Get(ctx context.Context, idCode string) (*Trans
 	return transfer, err
 }
 
+// Verify returns the transfer with the given reference
+// For more details see https://paystack.com/docs/api/transfer/#verify
+func (s *DefaultTransferService) Verify(ctx context.Context, reference string) (*Transfer, error) {
+	u := fmt.Sprintf("/transfer/verify/%s", url.PathEscape(reference))
+	transfer := &Transfer{}
+	err := s.Client.Call(ctx, http.MethodGet, u, nil, transfer)
+	return transfer, err
+}
+
 // List returns a list of transfers.
 // For more details see https://developers.paystack.co/v1.0/reference#list-transfers
 func (s *DefaultTransferService) List(ctx context.Context) (*List, error) {
@@ -94,7 +188,7 @@ func (s *DefaultTransferService) ResendOTP(ctx context.Context, transferCode, re
 	data.Add("transfer_code", transferCode)
 	data.Add("reason", reason)
 	resp := response.Response{}
-	err := s.Client.Call(ctx, http.MethodPost, "/transfer/resend_otp", data, &resp)
+	err := s.Client.Call(ctx, http.MethodPost, "/transfer/resend_otp", response.RequestValues(data), &resp)
 	return resp, err
 }
 
@@ -124,7 +218,7 @@ func (s *DefaultTransferService) FinalizeOTPDisable(ctx context.Context, otp str
 	data := url.Values{}
 	data.Add("otp", otp)
 	resp := response.Response{}
-	err := s.Client.Call(ctx, http.MethodPost, "/transfer/disable_otp_finalize", data, &resp)
+	err := s.Client.Call(ctx, http.MethodPost, "/transfer/disable_otp_finalize", response.RequestValues(data), &resp)
 	return resp, err
 }
 
@@ -150,3 +244,51 @@ func (s *DefaultTransferService) ListRecipientsN(ctx context.Context, count, off
 	err := s.Client.Call(ctx, http.MethodGet, u, nil, &resp)
 	return resp, err
 }
+
+// GetRecipient returns the details of a transfer recipient
+// For more details see https://paystack.com/docs/api/transfer-recipient/#fetch
+func (s *DefaultTransferService) GetRecipient(ctx context.Context, idCode string) (*Recipient, error) {
+	u := fmt.Sprintf("/transferrecipient/%s", idCode)
+	recipient := &Recipient{}
+	err := s.Client.Call(ctx, http.MethodGet, u, nil, recipient)
+	return recipient, err
+}
+
+// UpdateRecipient updates the name and email of a transfer recipient
+// For more details see https://paystack.com/docs/api/transfer-recipient/#update
+func (s *DefaultTransferService) UpdateRecipient(ctx context.Context, idCode, name, email string) (response.Response, error) {
+	u := fmt.Sprintf("/transferrecipient/%s", idCode)
+	reqBody := struct {
+		Name  string `json:"name"`
+		Email string `json:"email,omitempty"`
+	}{
+		Name:  name,
+		Email: email,
+	}
+	resp := response.Response{}
+	err := s.Client.Call(ctx, http.MethodPut, u, reqBody, &resp)
+	return resp, err
+}
+
+// DeleteRecipient deletes a transfer recipient. The recipient is set inactive rather than removed.
+// For more details see https://paystack.com/docs/api/transfer-recipient/#delete
+func (s *DefaultTransferService) DeleteRecipient(ctx context.Context, idCode string) (response.Response, error) {
+	u := fmt.Sprintf("/transferrecipient/%s", idCode)
+	resp := response.Response{}
+	err := s.Client.Call(ctx, http.MethodDelete, u, nil, &resp)
+	return resp, err
+}
+
+// BulkCreateRecipients creates several transfer recipients in a single request.
+// The call succeeds even when some recipients are rejected; check the Errors of the result.
+// For more details see https://paystack.com/docs/api/transfer-recipient/#bulk
+func (s *DefaultTransferService) BulkCreateRecipients(ctx context.Context, recipients []Recipient) (*BulkRecipientResult, error) {
+	reqBody := struct {
+		Batch []Recipient `json:"batch"`
+	}{
+		Batch: recipients,
+	}
+	result := &BulkRecipientResult{}
+	err := s.Client.Call(ctx, http.MethodPost, "/transferrecipient/bulk", reqBody, result)
+	return result, err
+}
@@ -2,8 +2,15 @@ package transfer
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/hub1989/paystack-api-wrapper/client"
 	"github.com/hub1989/paystack-api-wrapper/configuration"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
 	"testing"
 )
 
@@ -23,7 +30,7 @@ func init() {
 //	}
 //
 //	recipient := &Recipient{
-//		Type:          "Nuban",
+//		Type:          RecipientTypeNUBAN,
 //		Name:          "Customer 1",
 //		Description:   "Demo customer",
 //		AccountNumber: "0001234560",
@@ -77,14 +84,14 @@ func TestBulkTransfer(t *testing.T) {
 	transfer := &BulkTransfer{
 		Source:   "balance",
 		Currency: "NGN",
-		Transfers: []map[string]interface{}{
+		Transfers: []BulkTransferItem{
 			{
-				"amount":    50000,
-				"recipient": recipients[0].RecipientCode,
+				Amount:    50000,
+				Recipient: recipients[0].RecipientCode,
 			},
 			{
-				"amount":    50000,
-				"recipient": recipients[1].RecipientCode,
+				Amount:    50000,
+				Recipient: recipients[1].RecipientCode,
 			},
 		},
 	}
@@ -124,7 +131,7 @@ func TestTransferList(t *testing.T) {
 
 func createDemoRecipients() ([]*Recipient, error) {
 	recipient1 := &Recipient{
-		Type:          "Nuban",
+		Type:          RecipientTypeNUBAN,
 		Name:          "Customer 1",
 		Description:   "Demo customer",
 		AccountNumber: "0001234560",
@@ -134,7 +141,7 @@ func createDemoRecipients() ([]*Recipient, error) {
 	}
 
 	recipient2 := &Recipient{
-		Type:          "Nuban",
+		Type:          RecipientTypeNUBAN,
 		Name:          "Customer 2",
 		Description:   "Demo customer",
 		AccountNumber: "0001234560",
@@ -144,7 +151,7 @@ func createDemoRecipients() ([]*Recipient, error) {
 	}
 
 	recipient3 := &Recipient{
-		Type:          "Nuban",
+		Type:          RecipientTypeNUBAN,
 		Name:          "Customer 2",
 		Description:   "Demo customer",
 		AccountNumber: "0001234560",
@@ -153,9 +160,190 @@ func createDemoRecipients() ([]*Recipient, error) {
 		Metadata:      map[string]interface{}{"job": "Plumber"},
 	}
 
-	_, err := service.CreateRecipient(context.TODO(), recipient1)
-	_, err = service.CreateRecipient(context.TODO(), recipient2)
-	_, err = service.CreateRecipient(context.TODO(), recipient3)
+	var created []*Recipient
+	for _, recipient := range []*Recipient{recipient1, recipient2, recipient3} {
+		r, err := service.CreateRecipient(context.TODO(), recipient)
+		if err != nil {
+			return nil, err
+		}
+		created = append(created, r)
+	}
+	return created, nil
+}
+
+func TestTransferRecipientCRUD(t *testing.T) {
+	recipients, err := createDemoRecipients()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recipient, err := service.GetRecipient(context.TODO(), recipients[0].RecipientCode)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if recipient.Type != RecipientTypeNUBAN {
+		t.Errorf("Expected recipient type %v, got %v", RecipientTypeNUBAN, recipient.Type)
+	}
+
+	if _, err = service.UpdateRecipient(context.TODO(), recipient.RecipientCode, "Customer 1 Renamed", ""); err != nil {
+		t.Error(err)
+	}
+
+	if _, err = service.DeleteRecipient(context.TODO(), recipient.RecipientCode); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBulkCreateRecipients(t *testing.T) {
+	result, err := service.BulkCreateRecipients(context.TODO(), []Recipient{
+		{Type: RecipientTypeNUBAN, Name: "Bulk Customer 1", AccountNumber: "0001234560", BankCode: "058", Currency: "NGN"},
+		{Type: RecipientTypeNUBAN, Name: "Bulk Customer 2", AccountNumber: "0001234560", BankCode: "058", Currency: "NGN"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Success)+len(result.Errors) != 2 {
+		t.Errorf("Expected a result for each recipient, got %+v", result)
+	}
+}
+
+func TestNewReference(t *testing.T) {
+	valid := regexp.MustCompile(`^[a-z0-9_-]{16,50}$`)
+
+	ref1, ref2 := NewReference(), NewReference()
+	if !valid.MatchString(ref1) {
+		t.Errorf("Expected a valid transfer reference, got %q", ref1)
+	}
+
+	if ref1 == ref2 {
+		t.Errorf("Expected unique references, got %q twice", ref1)
+	}
+}
+
+func TestInitiateWithReferenceGeneratorAndVerify(t *testing.T) {
+	transfers := map[string]map[string]interface{}{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data map[string]interface{}
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/transfer":
+			req := Request{}
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			data = map[string]interface{}{"reference": req.Reference, "transfer_code": "TRF_1", "status": "otp", "amount": req.Amount}
+			transfers[req.Reference] = data
+		case r.Method == http.MethodGet && len(r.URL.Path) > len("/transfer/verify/"):
+			data = transfers[r.URL.Path[len("/transfer/verify/"):]]
+		}
+		if data == nil {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": false, "message": "Transfer not found"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": true, "data": data})
+	}))
+	defer server.Close()
+
+	c := configuration.NewClient("sk_test", server.Client(), false)
+	c.BaseURL, _ = url.Parse(server.URL)
+	svc := &DefaultTransferService{Client: c, ReferenceGenerator: func() string { return "payout_0000000000001" }}
 
-	return []*Recipient{recipient1, recipient2, recipient3}, err
+	req := &Request{Source: "balance", Amount: 300, Recipient: "RCP_1"}
+	if _, err := svc.Initiate(context.TODO(), req); err != nil {
+		t.Fatal(err)
+	}
+
+	if req.Reference != "payout_0000000000001" {
+		t.Errorf("Expected the generated reference on the request, got %q", req.Reference)
+	}
+
+	transfer, err := svc.Verify(context.TODO(), req.Reference)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if transfer.TransferCode != "TRF_1" || transfer.Status != StatusOTP || transfer.Status.Terminal() {
+		t.Errorf("Expected pending OTP transfer TRF_1, got %+v", transfer)
+	}
+}
+
+func TestMakeBulkTransferChunksAndReportsFailures(t *testing.T) {
+	var chunks []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := BulkTransfer{}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		chunks = append(chunks, len(req.Transfers))
+
+		// reject the second chunk
+		if len(chunks) == 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": false, "message": "Insufficient balance"})
+			return
+		}
+
+		var data []interface{}
+		for _, item := range req.Transfers {
+			// drop one item from the response
+			if item.Reference == "item_000000000000002" {
+				continue
+			}
+			data = append(data, map[string]interface{}{
+				"reference":     item.Reference,
+				"recipient":     item.Recipient,
+				"amount":        item.Amount,
+				"transfer_code": "TRF_" + item.Reference,
+				"status":        "received",
+			})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": true, "data": data})
+	}))
+	defer server.Close()
+
+	c := configuration.NewClient("sk_test", server.Client(), false)
+	c.BaseURL, _ = url.Parse(server.URL)
+	svc := &DefaultTransferService{Client: c}
+
+	req := &BulkTransfer{Source: "balance", Currency: "NGN"}
+	for i := 0; i < 250; i++ {
+		req.Transfers = append(req.Transfers, BulkTransferItem{Amount: 1000, Recipient: "RCP_1", Reference: fmt.Sprintf("item_%015d", i)})
+	}
+	req.Transfers[249].Reference = ""
+
+	result, err := svc.MakeBulkTransfer(context.TODO(), req)
+
+	if len(chunks) != 3 || chunks[0] != 100 || chunks[1] != 100 || chunks[2] != 50 {
+		t.Errorf("Expected chunks of 100, 100 and 50 items, got %v", chunks)
+	}
+
+	if req.Transfers[249].Reference == "" {
+		t.Error("Expected a generated reference for the item without one")
+	}
+
+	bulkErr, ok := err.(*BulkTransferError)
+	if !ok {
+		t.Fatalf("Expected a *BulkTransferError, got %v", err)
+	}
+
+	if len(bulkErr.Failed) != 101 || !errors.Is(bulkErr.Failed["item_000000000000002"], ErrNoResult) {
+		t.Errorf("Expected the rejected chunk and the missing item to fail, got %d failures", len(bulkErr.Failed))
+	}
+
+	if _, ok := bulkErr.Failed["item_000000000000150"]; !ok {
+		t.Error("Expected the items of the rejected chunk to fail")
+	}
+
+	if len(result.Transfers) != 149 || result.Transfers["item_000000000000001"].TransferCode != "TRF_item_000000000000001" {
+		t.Errorf("Expected 149 queued transfers keyed by reference, got %d", len(result.Transfers))
+	}
+}
+
+func TestMakeBulkTransferRejectsDuplicateReferences(t *testing.T) {
+	req := &BulkTransfer{Transfers: []BulkTransferItem{
+		{Amount: 1000, Recipient: "RCP_1", Reference: "dup_0000000000000"},
+		{Amount: 1000, Recipient: "RCP_2", Reference: "dup_0000000000000"},
+	}}
+
+	if _, err := (&DefaultTransferService{Client: c}).MakeBulkTransfer(context.TODO(), req); !errors.Is(err, ErrDuplicateReference) {
+		t.Errorf("Expected ErrDuplicateReference, got %v", err)
+	}
 }